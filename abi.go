@@ -0,0 +1,81 @@
+package int256
+
+import (
+	"fmt"
+
+	"github.com/holiman/uint256"
+)
+
+// checkABIBits panics if bits is not the size of a Solidity intN type,
+// that is a multiple of 8 in [8, 256].
+func checkABIBits(bits uint) {
+	if bits == 0 || bits > 256 || bits%8 != 0 {
+		panic(fmt.Sprintf("invalid ABI integer size %d", bits))
+	}
+}
+
+// fitsABIBits reports whether the two's-complement word w is the sign
+// extension of its low bits bits, that is whether it holds a valid intN.
+func fitsABIBits(w *uint256.Int, bits uint) bool {
+	if bits == 256 {
+		return true
+	}
+	var e, byteNum uint256.Int
+	e.ExtendSign(w, byteNum.SetUint64(uint64(bits/8-1)))
+	return e.Eq(w)
+}
+
+// PackABI returns z encoded as a Solidity ABI static value of type intN,
+// with N = bits: a 32-byte big-endian two's-complement word, sign-extended
+// from bit N-1. It returns ErrOverflow if z does not fit in an intN.
+// PackABI panics if bits is not a multiple of 8 in [8, 256].
+func (z *Int) PackABI(bits uint) ([32]byte, error) {
+	checkABIBits(bits)
+	var w uint256.Int
+	z.toWord(&w)
+	if !fitsABIBits(&w, bits) {
+		return [32]byte{}, ErrOverflow
+	}
+	return w.Bytes32(), nil
+}
+
+// UnpackABI decodes the first 32 bytes of data as a Solidity ABI static
+// value of type intN, with N = bits, and returns it as a new Int.
+//
+// A valid word is the sign extension of its low N bits. Words with dirty
+// high bits, which a conforming encoder never produces, are rejected with
+// ErrOverflow rather than being truncated. If data is shorter than 32 bytes,
+// UnpackABI returns ErrInvalidLength. It panics if bits is not a multiple
+// of 8 in [8, 256].
+func UnpackABI(data []byte, bits uint) (*Int, error) {
+	checkABIBits(bits)
+	if len(data) < 32 {
+		return nil, ErrInvalidLength
+	}
+	var w uint256.Int
+	w.SetBytes32(data[:32])
+	if !fitsABIBits(&w, bits) {
+		return nil, ErrOverflow
+	}
+	return new(Int).setWord(&w), nil
+}
+
+// EncodePacked returns z encoded as an intN, with N = bits, in the tightly
+// packed form of Solidity's abi.encodePacked: bits/8 big-endian
+// two's-complement bytes without padding. It returns ErrOverflow if z does
+// not fit in an intN. EncodePacked panics if bits is not a multiple of 8
+// in [8, 256].
+func (z *Int) EncodePacked(bits uint) ([]byte, error) {
+	return z.AppendPacked(nil, bits)
+}
+
+// AppendPacked appends z in the form produced by EncodePacked to buf and
+// returns the extended buffer, so that the arguments of abi.encodePacked
+// can be concatenated. On error buf is returned unchanged.
+func (z *Int) AppendPacked(buf []byte, bits uint) ([]byte, error) {
+	word, err := z.PackABI(bits)
+	if err != nil {
+		return buf, err
+	}
+	return append(buf, word[32-bits/8:]...), nil
+}
@@ -0,0 +1,180 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func abiWord(s string) []byte {
+	b, err := hex.DecodeString(strings.Repeat("0", 64-len(s)) + s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestInt_PackABI(t *testing.T) {
+	ff := strings.Repeat("f", 64)
+	tests := []struct {
+		name    string
+		x       *Int
+		bits    uint
+		want    []byte
+		wantErr error
+	}{
+		{name: "Should pack positive int256", x: NewInt(1), bits: 256, want: abiWord("1")},
+		{name: "Should pack negative int256", x: NewInt(-1), bits: 256, want: abiWord(ff)},
+		{name: "Should sign-extend negative int24", x: NewInt(-887272), bits: 24, want: abiWord(ff[:58] + "f27618")},
+		{name: "Should pack hi int8", x: NewInt(127), bits: 8, want: abiWord("7f")},
+		{name: "Should pack lo int8", x: NewInt(-128), bits: 8, want: abiWord(ff[:62] + "80")},
+		{name: "Should pack lo int256", x: MinInt256(), bits: 256, want: abiWord("8" + strings.Repeat("0", 63))},
+		{name: "Should reject int8 overflow", x: NewInt(128), bits: 8, wantErr: ErrOverflow},
+		{name: "Should reject int8 underflow", x: NewInt(-129), bits: 8, wantErr: ErrOverflow},
+		{name: "Should reject int248 overflow", x: MaxInt256(), bits: 248, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.x.PackABI(tt.bits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Int.PackABI(%d) error = %v, want %v", tt.bits, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(got[:], tt.want) {
+				t.Errorf("Int.PackABI(%d) = %x, want %x", tt.bits, got, tt.want)
+			}
+			back, err := UnpackABI(got[:], tt.bits)
+			if err != nil || back.Cmp(tt.x) != 0 {
+				t.Errorf("UnpackABI(%x, %d) = %v, %v, want %v", got, tt.bits, back, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestUnpackABI(t *testing.T) {
+	ff := strings.Repeat("f", 64)
+	tests := []struct {
+		name    string
+		data    []byte
+		bits    uint
+		want    *Int
+		wantErr error
+	}{
+		{name: "Should unpack Swap amount", data: abiWord(ff[:60] + "d8f0"), bits: 256, want: NewInt(-10000)},
+		{name: "Should unpack int24 tick", data: abiWord(ff[:58] + "f27618"), bits: 24, want: NewInt(-887272)},
+		{name: "Should ignore trailing data", data: append(abiWord("2a"), abiWord(ff)...), bits: 8, want: NewInt(42)},
+		{name: "Should reject zero-padded negative int24", data: abiWord("f27618"), bits: 24, wantErr: ErrOverflow},
+		{name: "Should reject dirty high byte", data: abiWord("01" + strings.Repeat("0", 60) + "2a"), bits: 8, wantErr: ErrOverflow},
+		{name: "Should reject one-padded positive int8", data: abiWord(ff[:62] + "7f"), bits: 8, wantErr: ErrOverflow},
+		{name: "Should reject short data", data: make([]byte, 31), bits: 256, wantErr: ErrInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnpackABI(tt.data, tt.bits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UnpackABI(%d) error = %v, want %v", tt.bits, err, tt.wantErr)
+			}
+			if err == nil && got.Cmp(tt.want) != 0 {
+				t.Errorf("UnpackABI(%d) = %v, want %v", tt.bits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_PackABIBounds(t *testing.T) {
+	one := big.NewInt(1)
+	for bits := uint(8); bits <= 256; bits += 8 {
+		hi := new(big.Int).Sub(new(big.Int).Lsh(one, bits-1), one)
+		lo := new(big.Int).Neg(new(big.Int).Lsh(one, bits-1))
+		for _, x := range []*big.Int{hi, lo, big.NewInt(0), big.NewInt(-1)} {
+			w, err := MustFromBig(x).PackABI(bits)
+			if err != nil {
+				t.Fatalf("Int.PackABI(%v, %d) error = %v", x, bits, err)
+			}
+			got, err := UnpackABI(w[:], bits)
+			if err != nil || got.ToBig().Cmp(x) != 0 {
+				t.Errorf("UnpackABI(%x, %d) = %v, %v, want %v", w, bits, got, err, x)
+			}
+		}
+		if bits == 256 {
+			continue
+		}
+		for _, x := range []*big.Int{new(big.Int).Add(hi, one), new(big.Int).Sub(lo, one)} {
+			if _, err := MustFromBig(x).PackABI(bits); !errors.Is(err, ErrOverflow) {
+				t.Errorf("Int.PackABI(%v, %d) error = %v, want %v", x, bits, err, ErrOverflow)
+			}
+			var w [32]byte
+			MustFromBig(x).PutBytes32(w[:])
+			if _, err := UnpackABI(w[:], bits); !errors.Is(err, ErrOverflow) {
+				t.Errorf("UnpackABI(%x, %d) error = %v, want %v", w, bits, err, ErrOverflow)
+			}
+		}
+	}
+}
+
+func TestInt_PackABIInvalidSize(t *testing.T) {
+	for _, bits := range []uint{0, 7, 12, 264} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Int.PackABI(%d) did not panic", bits)
+				}
+			}()
+			NewInt(1).PackABI(bits)
+		}()
+	}
+}
+
+func TestInt_EncodePacked(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       *Int
+		bits    uint
+		want    string
+		wantErr error
+	}{
+		{name: "Should pack int8 into one byte", x: NewInt(-1), bits: 8, want: "ff"},
+		{name: "Should pack int24 into three bytes", x: NewInt(-887272), bits: 24, want: "f27618"},
+		{name: "Should pack int16 positive value", x: NewInt(0x1234), bits: 16, want: "1234"},
+		{name: "Should pack int256 into a full word", x: NewInt(1), bits: 256, want: strings.Repeat("0", 63) + "1"},
+		{name: "Should reject int16 overflow", x: NewInt(0x8000), bits: 16, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.x.EncodePacked(tt.bits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Int.EncodePacked(%d) error = %v, want %v", tt.bits, err, tt.wantErr)
+			}
+			if err == nil && hex.EncodeToString(got) != tt.want {
+				t.Errorf("Int.EncodePacked(%d) = %x, want %v", tt.bits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_AppendPacked(t *testing.T) {
+	// abi.encodePacked(int8(-1), int24(-887272), int16(300))
+	buf := []byte{}
+	var err error
+	for _, arg := range []struct {
+		x    *Int
+		bits uint
+	}{{NewInt(-1), 8}, {NewInt(-887272), 24}, {NewInt(300), 16}} {
+		if buf, err = arg.x.AppendPacked(buf, arg.bits); err != nil {
+			t.Fatalf("Int.AppendPacked() error = %v", err)
+		}
+	}
+	if got, want := hex.EncodeToString(buf), "fff27618012c"; got != want {
+		t.Errorf("Int.AppendPacked() = %v, want %v", got, want)
+	}
+
+	got, err := NewInt(128).AppendPacked(buf, 8)
+	if !errors.Is(err, ErrOverflow) || !bytes.Equal(got, buf) {
+		t.Errorf("Int.AppendPacked() = %x, %v, want buffer unchanged and %v", got, err, ErrOverflow)
+	}
+}
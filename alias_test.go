@@ -0,0 +1,177 @@
+package int256
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// The tests below check the math/big contract that the receiver may alias
+// any operand and that every method returns its receiver.
+
+func TestInt_AliasingBinary(t *testing.T) {
+	ops := map[string]func(z, x, y *Int) *Int{
+		"Add":    (*Int).Add,
+		"Sub":    (*Int).Sub,
+		"Mul":    (*Int).Mul,
+		"Quo":    (*Int).Quo,
+		"Rem":    (*Int).Rem,
+		"Div":    (*Int).Div,
+		"Mod":    (*Int).Mod,
+		"And":    (*Int).And,
+		"Or":     (*Int).Or,
+		"Xor":    (*Int).Xor,
+		"AndNot": (*Int).AndNot,
+		"SDiv":   (*Int).SDiv,
+		"SMod":   (*Int).SMod,
+		"SatAdd": (*Int).SatAdd,
+		"SatSub": (*Int).SatSub,
+		"SatMul": (*Int).SatMul,
+		"Exp": func(z, x, y *Int) *Int {
+			return z.Exp(x, new(Int).And(y, NewInt(7)), nil)
+		},
+		"AddOverflow": func(z, x, y *Int) *Int {
+			z, _ = z.AddOverflow(x, y)
+			return z
+		},
+		"SubOverflow": func(z, x, y *Int) *Int {
+			z, _ = z.SubOverflow(x, y)
+			return z
+		},
+		"MulOverflow": func(z, x, y *Int) *Int {
+			z, _ = z.MulOverflow(x, y)
+			return z
+		},
+		"QuoOverflow": func(z, x, y *Int) *Int {
+			z, _ = z.QuoOverflow(x, y)
+			return z
+		},
+		"ExpOverflow": func(z, x, y *Int) *Int {
+			z, _ = z.ExpOverflow(x, new(Int).And(y, NewInt(7)))
+			return z
+		},
+		"Set": func(z, x, y *Int) *Int { return z.Set(x) },
+	}
+	values := []*big.Int{big.NewInt(-7), big.NewInt(3), bigMinInt256, bigMaxInt256}
+	for name, op := range ops {
+		for _, x := range values {
+			for _, y := range values {
+				want := op(new(Int), MustFromBig(x), MustFromBig(y))
+
+				z := MustFromBig(x)
+				if got := op(z, z, MustFromBig(y)); got != z || !reflect.DeepEqual(z, want) {
+					t.Errorf("z.%s(z, y) with z = %v, y = %v: got %v, want %v", name, x, y, z, want)
+				}
+				z = MustFromBig(y)
+				if got := op(z, MustFromBig(x), z); got != z || !reflect.DeepEqual(z, want) {
+					t.Errorf("z.%s(x, z) with x = %v, z = %v: got %v, want %v", name, x, y, z, want)
+				}
+				if x != y {
+					continue
+				}
+				z = MustFromBig(x)
+				if got := op(z, z, z); got != z || !reflect.DeepEqual(z, want) {
+					t.Errorf("z.%s(z, z) with z = %v: got %v, want %v", name, x, z, want)
+				}
+			}
+		}
+	}
+}
+
+func TestInt_AliasingTernary(t *testing.T) {
+	ops := map[string]func(z, x, y, m *Int) *Int{
+		"AddMod": (*Int).AddMod,
+		"MulMod": (*Int).MulMod,
+		"ExpMod": (*Int).Exp,
+		"MulDiv": func(z, x, y, d *Int) *Int {
+			z, _ = z.MulDiv(x, y, d, RoundHalfEven)
+			return z
+		},
+	}
+	x, y, m := big.NewInt(-7), big.NewInt(5), big.NewInt(11)
+	for name, op := range ops {
+		want := op(new(Int), MustFromBig(x), MustFromBig(y), MustFromBig(m))
+		for i, alias := range []string{"x", "y", "m"} {
+			args := []*Int{MustFromBig(x), MustFromBig(y), MustFromBig(m)}
+			z := args[i]
+			if got := op(z, args[0], args[1], args[2]); got != z || !reflect.DeepEqual(z, want) {
+				t.Errorf("z.%s with z aliasing %s: got %v, want %v", name, alias, z, want)
+			}
+		}
+	}
+}
+
+func TestInt_AliasingUnary(t *testing.T) {
+	ops := map[string]func(z, x *Int) *Int{
+		"Neg":        (*Int).Neg,
+		"Abs":        (*Int).Abs,
+		"Not":        (*Int).Not,
+		"Lsh":        func(z, x *Int) *Int { return z.Lsh(x, 3) },
+		"Rsh":        func(z, x *Int) *Int { return z.Rsh(x, 3) },
+		"LogicalRsh": func(z, x *Int) *Int { return z.LogicalRsh(x, 3) },
+		"SetBit":     func(z, x *Int) *Int { return z.SetBit(x, 2, 1) },
+		"SignExtend": func(z, x *Int) *Int { return z.SignExtend(x, 0) },
+		"Sqrt":       func(z, x *Int) *Int { return z.Sqrt(new(Int).Abs(x)) },
+		"ModInverse": func(z, x *Int) *Int { return z.ModInverse(x, NewInt(11)) },
+		"LshOverflow": func(z, x *Int) *Int {
+			z, _ = z.LshOverflow(x, 3)
+			return z
+		},
+		"NegOverflow": func(z, x *Int) *Int {
+			z, _ = z.NegOverflow(x)
+			return z
+		},
+	}
+	for name, op := range ops {
+		for _, x := range []*big.Int{big.NewInt(-7), big.NewInt(3), bigMaxInt256} {
+			want := op(new(Int), MustFromBig(x))
+			z := MustFromBig(x)
+			if got := op(z, z); got != z || !reflect.DeepEqual(z, want) {
+				t.Errorf("z.%s(z) with z = %v: got %v, want %v", name, x, z, want)
+			}
+		}
+	}
+}
+
+func TestInt_AliasingPairs(t *testing.T) {
+	x, y := big.NewInt(-7), big.NewInt(3)
+	wantQ, wantR := new(Int).QuoRem(MustFromBig(x), MustFromBig(y), new(Int))
+	wantD, wantM := new(Int).DivMod(MustFromBig(x), MustFromBig(y), new(Int))
+
+	for _, alias := range []string{"x", "y"} {
+		args := []*Int{MustFromBig(x), MustFromBig(y)}
+		z, r := new(Int), new(Int)
+		if alias == "x" {
+			z = args[0]
+		} else {
+			r = args[1]
+		}
+		if q, rem := z.QuoRem(args[0], args[1], r); q != z || rem != r || !reflect.DeepEqual(z, wantQ) || !reflect.DeepEqual(r, wantR) {
+			t.Errorf("QuoRem aliasing %s: got %v, %v, want %v, %v", alias, z, r, wantQ, wantR)
+		}
+
+		args = []*Int{MustFromBig(x), MustFromBig(y)}
+		z, r = new(Int), new(Int)
+		if alias == "x" {
+			r = args[0]
+		} else {
+			z = args[1]
+		}
+		if d, m := z.DivMod(args[0], args[1], r); d != z || m != r || !reflect.DeepEqual(z, wantD) || !reflect.DeepEqual(r, wantM) {
+			t.Errorf("DivMod aliasing %s: got %v, %v, want %v, %v", alias, z, r, wantD, wantM)
+		}
+	}
+
+	a, b := MustFromBig(big.NewInt(240)), MustFromBig(big.NewInt(-46))
+	wantX, wantY := new(Int), new(Int)
+	want := new(Int).GCD(wantX, wantY, a, b)
+	z := MustFromBig(big.NewInt(240))
+	if got := z.GCD(nil, nil, z, b); got != z || !reflect.DeepEqual(z, want) {
+		t.Errorf("GCD aliasing a: got %v, want %v", z, want)
+	}
+
+	z = NewInt(7)
+	if got, err := z.SetString("-12", 10); got != z || err != nil || z.Int64() != -12 {
+		t.Errorf("Int.SetString() = %v, %v, want z set to -12", got, err)
+	}
+}
@@ -0,0 +1,209 @@
+package int256
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+// The benchmarks compare Int with math/big and with raw uint256 on the
+// magnitudes, for operands in the ranges a pricing engine sees: one 128-bit
+// negative value and one 64-bit positive value.
+var (
+	benchX = MustFromBig(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(0x1234567890abcdef), 64)))
+	benchY = NewInt(0x7edcba0987654321)
+	bench3 = NewInt(3)
+
+	// The constant exponent for the big and uint256 cases, hoisted like
+	// bench3 so that no case pays for allocating it in the timed loop.
+	bench3Big   = big.NewInt(3)
+	bench3Words = uint256.NewInt(3)
+)
+
+type benchOp struct {
+	name  string
+	int   func(z, x, y *Int)
+	big   func(z, x, y *big.Int)
+	words func(z, x, y *uint256.Int)
+}
+
+var benchOps = []benchOp{
+	{
+		name:  "Add",
+		int:   func(z, x, y *Int) { z.Add(x, y) },
+		big:   func(z, x, y *big.Int) { z.Add(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Add(x, y) },
+	},
+	{
+		name:  "Sub",
+		int:   func(z, x, y *Int) { z.Sub(x, y) },
+		big:   func(z, x, y *big.Int) { z.Sub(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Sub(x, y) },
+	},
+	{
+		name:  "Mul",
+		int:   func(z, x, y *Int) { z.Mul(x, y) },
+		big:   func(z, x, y *big.Int) { z.Mul(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Mul(x, y) },
+	},
+	{
+		name:  "Quo",
+		int:   func(z, x, y *Int) { z.Quo(x, y) },
+		big:   func(z, x, y *big.Int) { z.Quo(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Div(x, y) },
+	},
+	{
+		name:  "Rem",
+		int:   func(z, x, y *Int) { z.Rem(x, y) },
+		big:   func(z, x, y *big.Int) { z.Rem(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Mod(x, y) },
+	},
+	{
+		name:  "Div",
+		int:   func(z, x, y *Int) { z.Div(x, y) },
+		big:   func(z, x, y *big.Int) { z.Div(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Div(x, y) },
+	},
+	{
+		name:  "Mod",
+		int:   func(z, x, y *Int) { z.Mod(x, y) },
+		big:   func(z, x, y *big.Int) { z.Mod(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Mod(x, y) },
+	},
+	{
+		name:  "And",
+		int:   func(z, x, y *Int) { z.And(x, y) },
+		big:   func(z, x, y *big.Int) { z.And(x, y) },
+		words: func(z, x, y *uint256.Int) { z.And(x, y) },
+	},
+	{
+		name:  "Or",
+		int:   func(z, x, y *Int) { z.Or(x, y) },
+		big:   func(z, x, y *big.Int) { z.Or(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Or(x, y) },
+	},
+	{
+		name:  "Xor",
+		int:   func(z, x, y *Int) { z.Xor(x, y) },
+		big:   func(z, x, y *big.Int) { z.Xor(x, y) },
+		words: func(z, x, y *uint256.Int) { z.Xor(x, y) },
+	},
+	{
+		name:  "Lsh",
+		int:   func(z, x, y *Int) { z.Lsh(x, 96) },
+		big:   func(z, x, y *big.Int) { z.Lsh(x, 96) },
+		words: func(z, x, y *uint256.Int) { z.Lsh(x, 96) },
+	},
+	{
+		name:  "Rsh",
+		int:   func(z, x, y *Int) { z.Rsh(x, 7) },
+		big:   func(z, x, y *big.Int) { z.Rsh(x, 7) },
+		words: func(z, x, y *uint256.Int) { z.SRsh(x, 7) },
+	},
+	{
+		name:  "Exp",
+		int:   func(z, x, y *Int) { z.Exp(x, bench3, nil) },
+		big:   func(z, x, y *big.Int) { z.Exp(x, bench3Big, nil) },
+		words: func(z, x, y *uint256.Int) { z.Exp(x, bench3Words) },
+	},
+	{
+		name:  "MulMod",
+		int:   func(z, x, y *Int) { z.MulMod(x, x, y) },
+		big:   func(z, x, y *big.Int) { z.Mod(z.Mul(x, x), y) },
+		words: func(z, x, y *uint256.Int) { z.MulMod(x, x, y) },
+	},
+	{
+		name:  "Cmp",
+		int:   func(z, x, y *Int) { x.Cmp(y) },
+		big:   func(z, x, y *big.Int) { x.Cmp(y) },
+		words: func(z, x, y *uint256.Int) { x.Cmp(y) },
+	},
+}
+
+func BenchmarkInt(b *testing.B) {
+	for _, op := range benchOps {
+		b.Run(op.name+"/int256", func(b *testing.B) {
+			z := New()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				op.int(z, benchX, benchY)
+			}
+		})
+		b.Run(op.name+"/big", func(b *testing.B) {
+			z, x, y := new(big.Int), benchX.ToBig(), benchY.ToBig()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				op.big(z, x, y)
+			}
+		})
+		b.Run(op.name+"/uint256", func(b *testing.B) {
+			var w, x, y uint256.Int
+			benchX.toWord(&x)
+			benchY.toWord(&y)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				op.words(&w, &x, &y)
+			}
+		})
+	}
+}
+
+func BenchmarkInt_String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchX.String()
+	}
+}
+
+func BenchmarkBig_String(b *testing.B) {
+	x := benchX.ToBig()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = x.String()
+	}
+}
+
+// TestAllocs holds the hot path to its allocation budget: no allocations for
+// arithmetic once the receiver is initialized, and only the result string
+// for String, Text and Hex.
+func TestAllocs(t *testing.T) {
+	for _, op := range benchOps {
+		z := New()
+		if allocs := testing.AllocsPerRun(100, func() { op.int(z, benchX, benchY) }); allocs != 0 {
+			t.Errorf("Int.%s allocs = %v, want 0", op.name, allocs)
+		}
+	}
+	z, m := New(), New()
+	buf := make([]byte, 0, 64)
+	budgets := []struct {
+		name   string
+		budget float64
+		f      func()
+	}{
+		{"Neg", 0, func() { z.Neg(benchX) }},
+		{"LogicalRsh", 0, func() { z.LogicalRsh(benchX, 7) }},
+		{"LshOverflow", 0, func() { z.LshOverflow(benchX, 96) }},
+		{"AddOverflow", 0, func() { z.AddOverflow(benchX, benchY) }},
+		{"MulOverflow", 0, func() { z.MulOverflow(benchX, benchY) }},
+		{"MulDiv", 0, func() { z.MulDiv(benchX, benchY, bench3, RoundHalfEven) }},
+		{"CheckedAdd", 0, func() { _, _ = z.CheckedAdd(benchX, benchY) }},
+		{"CheckedMul", 0, func() { _, _ = z.CheckedMul(benchX, benchY) }},
+		{"SatAdd", 0, func() { z.SatAdd(benchX, benchY) }},
+		{"SatMul", 0, func() { z.SatMul(benchX, benchY) }},
+		{"QuoRem", 0, func() { z.QuoRem(benchX, benchY, m) }},
+		{"DivMod", 0, func() { z.DivMod(benchX, benchY, m) }},
+		{"ExpMod", 0, func() { z.Exp(benchX, benchY, bench3) }},
+		{"Sign", 0, func() { benchX.Sign() }},
+		{"Int64", 0, func() { benchX.Int64() }},
+		{"String", 1, func() { _ = benchX.String() }},
+		{"Text", 1, func() { _ = benchX.Text(2) }},
+		{"Hex", 1, func() { _ = benchX.Hex() }},
+		{"Append", 0, func() { _ = benchX.Append(buf[:0], 16) }},
+	}
+	for _, tt := range budgets {
+		if allocs := testing.AllocsPerRun(100, tt.f); allocs > tt.budget {
+			t.Errorf("Int.%s allocs = %v, want at most %v", tt.name, allocs, tt.budget)
+		}
+	}
+}
@@ -7,9 +7,9 @@ import (
 )
 
 var negativeOneBigInt = big.NewInt(-1)
-var zero = big.NewInt(0)
 
 func (z *Int) ToBig() *big.Int {
+	z = operand(z)
 	b := z.abs.ToBig()
 	if z.neg {
 		return b.Mul(b, negativeOneBigInt)
@@ -25,39 +25,15 @@ func MustFromBig(x *big.Int) *Int {
 	return big
 }
 
+// FromBig is a convenience-constructor from big.Int.
+// Returns a new Int and whether overflow occurred. If x does not fit in the
+// int256 range, the returned Int holds x wrapped around modulo 2^256.
 func FromBig(x *big.Int) (*Int, bool) {
-	num := x
-	neg := false
-	if x.Cmp(zero) == -1 {
-		num = new(big.Int).Mul(x, negativeOneBigInt)
-		neg = true
-	}
-	abs, overflow := uint256.FromBig(num)
-	/*
-		type Int [4]uint64
-		Currently, uint26 has maxWord is 4
-
-
-		bigInt has len(words) that can great than 4. So we can receive overflow error.
-
-		words := b.Bits()
-		overflow := len(words) > maxWords
-		ref from uint256 code
-		https://github.com/holiman/uint256/blob/master/conversion.go#L202
-	*/
-	if overflow {
-		abs, err := uint256.FromDecimal(x.String())
-		if err != nil {
-			return nil, overflow
-		}
-		neg = x.Sign() < 0
-		return &Int{
-			abs,
-			neg,
-		}, true
-	}
-	return &Int{
-		abs,
-		neg,
-	}, false
+	var w uint256.Int
+	// SetFromBig keeps the low 256 bits of the two's-complement encoding
+	// of x, so the sign of w differs from the sign of x exactly when x is
+	// outside the int256 range without exceeding 256 bits.
+	overflow := w.SetFromBig(x)
+	overflow = overflow || (x.Sign() < 0) != (w.Sign() < 0)
+	return new(Int).setWord(&w), overflow
 }
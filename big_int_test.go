@@ -82,6 +82,22 @@ func TestFromBig(t *testing.T) {
 			},
 			want1: false,
 		},
+		{
+			name: "Should return overflow when parsing number greater than max int256",
+			args: args{
+				x: new(big.Int).Lsh(big.NewInt(1), 255),
+			},
+			want:  MinInt256(),
+			want1: true,
+		},
+		{
+			name: "Should return correct value when parsing min int256",
+			args: args{
+				x: new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255)),
+			},
+			want:  MinInt256(),
+			want1: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
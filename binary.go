@@ -0,0 +1,99 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// Bytes32 returns z as a 32-byte big-endian two's-complement array, the
+// layout of an EVM word.
+func (z *Int) Bytes32() [32]byte {
+	var w uint256.Int
+	z.toWord(&w)
+	return w.Bytes32()
+}
+
+// PutBytes32 writes z to dest[:32] as a 32-byte big-endian two's-complement
+// word. It panics if dest is shorter than 32 bytes.
+func (z *Int) PutBytes32(dest []byte) {
+	var w uint256.Int
+	z.toWord(&w)
+	w.WriteToArray32((*[32]byte)(dest[:32]))
+}
+
+// WriteToSlice writes z to dest as a big-endian two's-complement number that
+// is right-aligned in dest. If dest is longer than 32 bytes, the leading
+// bytes are filled with the sign (0x00 or 0xff); if it is shorter, only the
+// low len(dest) bytes of z are written.
+func (z *Int) WriteToSlice(dest []byte) {
+	var w uint256.Int
+	z.toWord(&w)
+	fill := byte(0)
+	if w.Sign() < 0 {
+		fill = 0xff
+	}
+	for len(dest) > 32 {
+		dest[0] = fill
+		dest = dest[1:]
+	}
+	w.WriteToSlice(dest)
+}
+
+// SetBytes interprets b as a big-endian two's-complement number, sets z to
+// that value and returns z. A b shorter than 32 bytes is sign-extended from
+// its first byte, so SetBytes([]byte{0xff}) is -1, and an empty b is 0. If b
+// is longer than 32 bytes, only its last 32 bytes are used, and the value
+// wraps around like Int arithmetic.
+func (z *Int) SetBytes(b []byte) *Int {
+	var w uint256.Int
+	w.SetBytes(b)
+	if n := len(b); n > 0 && n < 32 && b[0]&0x80 != 0 {
+		var byteNum uint256.Int
+		w.ExtendSign(&w, byteNum.SetUint64(uint64(n-1)))
+	}
+	return z.setWord(&w)
+}
+
+// Bytes returns z as the shortest big-endian two's-complement byte slice
+// that SetBytes decodes back to z. Zero is encoded as an empty slice,
+// 127 as {0x7f}, 128 as {0x00, 0x80} and -1 as {0xff}.
+func (z *Int) Bytes() []byte {
+	var w, m uint256.Int
+	z.toWord(&w)
+	if w.IsZero() {
+		return []byte{}
+	}
+	// A one-byte sign and the magnitude bits of a non-negative word, or of
+	// the complement of a negative one, must fit.
+	m.Set(&w)
+	if w.Sign() < 0 {
+		m.Not(&m)
+	}
+	n := m.BitLen()/8 + 1
+	b := w.Bytes32()
+	return append([]byte(nil), b[32-n:]...)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the compact form
+// returned by Bytes.
+func (z *Int) MarshalBinary() ([]byte, error) {
+	return z.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It accepts any
+// big-endian two's-complement encoding of up to 32 bytes, as decoded by
+// SetBytes, and returns ErrOverflow for longer input, leaving z unchanged.
+func (z *Int) UnmarshalBinary(data []byte) error {
+	if len(data) > 32 {
+		return ErrOverflow
+	}
+	z.SetBytes(data)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (z *Int) GobEncode() ([]byte, error) {
+	return z.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (z *Int) GobDecode(buf []byte) error {
+	return z.UnmarshalBinary(buf)
+}
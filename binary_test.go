@@ -0,0 +1,156 @@
+package int256
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt_Bytes32(t *testing.T) {
+	for _, x := range boundaryValues() {
+		var w uint256.Int
+		w.SetFromBig(x)
+		want := w.Bytes32()
+
+		got := MustFromBig(x).Bytes32()
+		if got != want {
+			t.Errorf("Int.Bytes32(%v) = %x, want %x", x, got, want)
+		}
+
+		var put [40]byte
+		MustFromBig(x).PutBytes32(put[:])
+		if !bytes.Equal(put[:32], want[:]) || put[32] != 0 {
+			t.Errorf("Int.PutBytes32(%v) = %x, want %x", x, put, want)
+		}
+
+		if back := new(Int).SetBytes(got[:]); back.ToBig().Cmp(x) != 0 {
+			t.Errorf("Int.SetBytes(%x) = %v, want %v", got, back, x)
+		}
+	}
+}
+
+func TestInt_PutBytes32ShortBuffer(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int.PutBytes32() did not panic on a short buffer")
+		}
+	}()
+	NewInt(1).PutBytes32(make([]byte, 31))
+}
+
+func TestInt_SetBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+		want *Int
+	}{
+		{name: "Should decode empty input as zero", b: nil, want: New()},
+		{name: "Should decode positive byte", b: []byte{0x7f}, want: NewInt(127)},
+		{name: "Should sign-extend negative byte", b: []byte{0xff}, want: NewInt(-1)},
+		{name: "Should sign-extend min int8", b: []byte{0x80}, want: NewInt(-128)},
+		{name: "Should keep leading zero byte positive", b: []byte{0x00, 0x80}, want: NewInt(128)},
+		{name: "Should sign-extend multi-byte value", b: []byte{0xff, 0x7f}, want: NewInt(-129)},
+		{name: "Should decode full negative word", b: bytes.Repeat([]byte{0xff}, 32), want: NewInt(-1)},
+		{name: "Should use last 32 bytes of longer input", b: append([]byte{0x01}, bytes.Repeat([]byte{0xff}, 32)...), want: NewInt(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := NewInt(7)
+			if got := z.SetBytes(tt.b); got != z || !assert.ObjectsAreEqual(tt.want, got) {
+				t.Errorf("Int.SetBytes(%x) = %#v, want %v", tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_Bytes(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *Int
+		want []byte
+	}{
+		{name: "Should encode zero as empty", x: New(), want: []byte{}},
+		{name: "Should encode max int8 in one byte", x: NewInt(127), want: []byte{0x7f}},
+		{name: "Should add sign byte for 128", x: NewInt(128), want: []byte{0x00, 0x80}},
+		{name: "Should encode minus one in one byte", x: NewInt(-1), want: []byte{0xff}},
+		{name: "Should encode min int8 in one byte", x: NewInt(-128), want: []byte{0x80}},
+		{name: "Should add sign byte for -129", x: NewInt(-129), want: []byte{0xff, 0x7f}},
+		{name: "Should encode min int256 in 32 bytes", x: MinInt256(), want: append([]byte{0x80}, make([]byte, 31)...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.Bytes(); !bytes.Equal(got, tt.want) || got == nil {
+				t.Errorf("Int.Bytes() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+
+	for _, x := range boundaryValues() {
+		b := MustFromBig(x).Bytes()
+		if back := new(Int).SetBytes(b); back.ToBig().Cmp(x) != 0 {
+			t.Errorf("Int.SetBytes(Int.Bytes(%v)) = %v", x, back)
+		}
+		if len(b) > 1 && (b[0] == 0x00 && b[1]&0x80 == 0 || b[0] == 0xff && b[1]&0x80 != 0) {
+			t.Errorf("Int.Bytes(%v) = %x is not minimal", x, b)
+		}
+	}
+}
+
+func TestInt_WriteToSlice(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *Int
+		size int
+		want []byte
+	}{
+		{name: "Should right-align positive value", x: NewInt(0x1234), size: 4, want: []byte{0x00, 0x00, 0x12, 0x34}},
+		{name: "Should sign-fill negative value", x: NewInt(-2), size: 4, want: []byte{0xff, 0xff, 0xff, 0xfe}},
+		{name: "Should keep low bytes of short buffer", x: NewInt(0x1234), size: 1, want: []byte{0x34}},
+		{name: "Should sign-fill buffer longer than a word", x: NewInt(-1), size: 34, want: bytes.Repeat([]byte{0xff}, 34)},
+		{name: "Should zero-fill buffer longer than a word", x: NewInt(1), size: 34, want: append(make([]byte, 33), 0x01)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bytes.Repeat([]byte{0xaa}, tt.size)
+			tt.x.WriteToSlice(got)
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Int.WriteToSlice() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_MarshalBinary(t *testing.T) {
+	for _, x := range append(boundaryValues(), big.NewInt(0), big.NewInt(-300)) {
+		data, err := MustFromBig(x).MarshalBinary()
+		assert.Equal(t, nil, err)
+		z := NewInt(7)
+		assert.Equal(t, nil, z.UnmarshalBinary(data))
+		assert.Equal(t, 0, z.ToBig().Cmp(x))
+	}
+
+	z := NewInt(7)
+	if err := z.UnmarshalBinary(make([]byte, 33)); !errors.Is(err, ErrOverflow) || z.Int64() != 7 {
+		t.Errorf("Int.UnmarshalBinary() error = %v, z = %v, want %v and z unchanged", err, z, ErrOverflow)
+	}
+}
+
+func TestInt_Gob(t *testing.T) {
+	type pool struct {
+		Price *Int
+		Tick  Int
+	}
+	in := pool{Price: MinInt256(), Tick: *NewInt(-887272)}
+
+	var buf bytes.Buffer
+	assert.Equal(t, nil, gob.NewEncoder(&buf).Encode(&in))
+	var out pool
+	assert.Equal(t, nil, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, 0, out.Price.Cmp(in.Price))
+	assert.Equal(t, 0, out.Tick.Cmp(&in.Tick))
+}
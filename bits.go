@@ -0,0 +1,93 @@
+package int256
+
+import (
+	"math/bits"
+
+	"github.com/holiman/uint256"
+)
+
+// Like And and Or, the methods below give the results math/big would give
+// for the infinite two's-complement representation of their operands. Since
+// every Int fits in 256 bits, that representation is the 256-bit
+// two's-complement word sign-extended to the left.
+
+// Xor sets z = x ^ y and returns z.
+func (z *Int) Xor(x, y *Int) *Int {
+	var a, b uint256.Int
+	x.toWord(&a)
+	y.toWord(&b)
+	return z.setWord(a.Xor(&a, &b))
+}
+
+// Not sets z = ^x and returns z.
+func (z *Int) Not(x *Int) *Int {
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.Not(&w))
+}
+
+// AndNot sets z = x &^ y and returns z.
+func (z *Int) AndNot(x, y *Int) *Int {
+	var a, b uint256.Int
+	x.toWord(&a)
+	y.toWord(&b)
+	return z.setWord(a.And(&a, b.Not(&b)))
+}
+
+// Bit returns the value of the i'th bit of x. That is, it
+// returns (x>>i)&1. The bit index i must be >= 0.
+func (z *Int) Bit(i int) uint {
+	if i < 0 {
+		panic("negative bit index")
+	}
+	var w uint256.Int
+	z.toWord(&w)
+	if i > 255 {
+		// Bits beyond the word repeat the sign bit.
+		i = 255
+	}
+	return uint(w[i/64]>>(i%64)) & 1
+}
+
+// SetBit sets z to x, with x's i'th bit set to b (0 or 1), and returns z.
+// That is, if b is 1 SetBit sets z = x | (1 << i);
+// if b is 0 SetBit sets z = x &^ (1 << i). If b is not 0 or 1,
+// SetBit will panic.
+// As with Lsh, the result wraps around to int256: setting bit 255 flips the
+// sign, and setting bit i > 255 leaves z equal to x.
+func (z *Int) SetBit(x *Int, i int, b uint) *Int {
+	if i < 0 {
+		panic("negative bit index")
+	}
+	if b > 1 {
+		panic("set bit is not 0 or 1")
+	}
+	var w uint256.Int
+	x.toWord(&w)
+	if i < 256 {
+		mask := uint64(1) << (i % 64)
+		if b == 1 {
+			w[i/64] |= mask
+		} else {
+			w[i/64] &^= mask
+		}
+	}
+	return z.setWord(&w)
+}
+
+// BitLen returns the length of the absolute value of x in bits.
+// The bit length of 0 is 0.
+func (z *Int) BitLen() int {
+	return operand(z).abs.BitLen()
+}
+
+// TrailingZeroBits returns the number of consecutive least significant zero
+// bits of |x|.
+func (z *Int) TrailingZeroBits() uint {
+	for i, word := range operand(z).abs {
+		if word != 0 {
+			return uint(i*64 + bits.TrailingZeros64(word))
+		}
+	}
+	return 0
+}
@@ -0,0 +1,101 @@
+package int256
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestInt_BitwiseMatchesBig(t *testing.T) {
+	ops := []struct {
+		name string
+		op   func(z, x, y *Int) *Int
+		big  func(x, y *big.Int) *big.Int
+	}{
+		{"And", (*Int).And, func(x, y *big.Int) *big.Int { return new(big.Int).And(x, y) }},
+		{"Or", (*Int).Or, func(x, y *big.Int) *big.Int { return new(big.Int).Or(x, y) }},
+		{"Xor", (*Int).Xor, func(x, y *big.Int) *big.Int { return new(big.Int).Xor(x, y) }},
+		{"AndNot", (*Int).AndNot, func(x, y *big.Int) *big.Int { return new(big.Int).AndNot(x, y) }},
+	}
+	for _, op := range ops {
+		for _, x := range boundaryValues() {
+			for _, y := range boundaryValues() {
+				want := op.big(x, y)
+				if got := op.op(new(Int), MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.%s(%v, %v) = %v, want %v", op.name, x, y, got, want)
+				}
+			}
+		}
+	}
+	for _, x := range boundaryValues() {
+		want := new(big.Int).Not(x)
+		if got := new(Int).Not(MustFromBig(x)); got.ToBig().Cmp(want) != 0 {
+			t.Errorf("Int.Not(%v) = %v, want %v", x, got, want)
+		}
+		if got, want := MustFromBig(x).BitLen(), x.BitLen(); got != want {
+			t.Errorf("Int.BitLen(%v) = %v, want %v", x, got, want)
+		}
+		if got, want := MustFromBig(x).TrailingZeroBits(), x.TrailingZeroBits(); got != want {
+			t.Errorf("Int.TrailingZeroBits(%v) = %v, want %v", x, got, want)
+		}
+		for _, i := range []int{0, 1, 63, 64, 128, 254, 255, 256, 1000} {
+			if got, want := MustFromBig(x).Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int.Bit(%v, %d) = %v, want %v", x, i, got, want)
+			}
+			for _, b := range []uint{0, 1} {
+				want, _ := wrapBig(new(big.Int).SetBit(x, i, b))
+				if got := new(Int).SetBit(MustFromBig(x), i, b); got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.SetBit(%v, %d, %d) = %v, want %v", x, i, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestInt_SetBit(t *testing.T) {
+	type args struct {
+		x *Int
+		i int
+		b uint
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should set bit when performing positive number",
+			args: args{
+				x: NewInt(8),
+				i: 0,
+				b: 1,
+			},
+			want: NewInt(9),
+		},
+		{
+			name: "Should clear bit when performing negative number",
+			args: args{
+				x: NewInt(-1),
+				i: 0,
+				b: 0,
+			},
+			want: NewInt(-2),
+		},
+		{
+			name: "Should flip the sign when setting bit 255",
+			args: args{
+				x: NewInt(0),
+				i: 255,
+				b: 1,
+			},
+			want: MinInt256(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).SetBit(tt.args.x, tt.args.i, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.SetBit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// The Checked methods mirror Solidity 0.8 checked arithmetic: instead of
+// wrapping around they fail with ErrOverflow, ErrDivisionByZero or
+// ErrNegativeExponent, in which case z is left unchanged and nil is returned.
+
+// CheckedAdd sets z to the sum x+y and returns z, or returns ErrOverflow if
+// the sum does not fit in int256.
+func (z *Int) CheckedAdd(x, y *Int) (*Int, error) {
+	var w uint256.Int
+	if addWord(&w, x, y) {
+		return nil, ErrOverflow
+	}
+	return z.setWord(&w), nil
+}
+
+// CheckedSub sets z to the difference x-y and returns z, or returns
+// ErrOverflow if the difference does not fit in int256.
+func (z *Int) CheckedSub(x, y *Int) (*Int, error) {
+	var w uint256.Int
+	if subWord(&w, x, y) {
+		return nil, ErrOverflow
+	}
+	return z.setWord(&w), nil
+}
+
+// CheckedMul sets z to the product x*y and returns z, or returns ErrOverflow
+// if the product does not fit in int256.
+func (z *Int) CheckedMul(x, y *Int) (*Int, error) {
+	var w uint256.Int
+	if mulWord(&w, x, y) {
+		return nil, ErrOverflow
+	}
+	return z.setWord(&w), nil
+}
+
+// CheckedQuo sets z to the quotient x/y rounded toward zero and returns z.
+// It returns ErrDivisionByZero if y == 0, and ErrOverflow for
+// MinInt256 / -1.
+func (z *Int) CheckedQuo(x, y *Int) (*Int, error) {
+	if y.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	if quoOverflows(x, y) {
+		return nil, ErrOverflow
+	}
+	return z.Quo(x, y), nil
+}
+
+// CheckedDiv sets z to the Euclidean quotient x div y and returns z.
+// It returns ErrDivisionByZero if y == 0, and ErrOverflow for
+// MinInt256 div -1.
+func (z *Int) CheckedDiv(x, y *Int) (*Int, error) {
+	if y.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	if quoOverflows(x, y) {
+		return nil, ErrOverflow
+	}
+	return z.Div(x, y), nil
+}
+
+// CheckedMod sets z to the Euclidean modulus x mod y, which is never
+// negative, and returns z. It returns ErrDivisionByZero if y == 0.
+func (z *Int) CheckedMod(x, y *Int) (*Int, error) {
+	if y.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	return z.Mod(x, y), nil
+}
+
+// CheckedRem sets z to the remainder x%y, which takes the sign of x, and
+// returns z. It returns ErrDivisionByZero if y == 0.
+func (z *Int) CheckedRem(x, y *Int) (*Int, error) {
+	if y.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	return z.Rem(x, y), nil
+}
+
+// CheckedNeg sets z to -x and returns z, or returns ErrOverflow if x is
+// MinInt256.
+func (z *Int) CheckedNeg(x *Int) (*Int, error) {
+	if x.isMinInt256() {
+		return nil, ErrOverflow
+	}
+	return z.Neg(x), nil
+}
+
+// CheckedExp sets z to x**y and returns z, or returns ErrOverflow if the
+// power does not fit in int256. Unlike Exp, which sets z to 1 for y < 0,
+// it returns ErrNegativeExponent if y < 0.
+func (z *Int) CheckedExp(x, y *Int) (*Int, error) {
+	if y.Sign() < 0 {
+		return nil, ErrNegativeExponent
+	}
+	var w uint256.Int
+	if expWord(&w, x, y) {
+		return nil, ErrOverflow
+	}
+	return z.setWord(&w), nil
+}
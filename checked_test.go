@@ -0,0 +1,150 @@
+package int256
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestInt_Checked(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func(z *Int) (*Int, error)
+		want    *Int
+		wantErr error
+	}{
+		{
+			name: "Should return correct value when adding in range",
+			op:   func(z *Int) (*Int, error) { return z.CheckedAdd(NewInt(-10), NewInt(7)) },
+			want: NewInt(-3),
+		},
+		{
+			name:    "Should return overflow error when adding past max int256",
+			op:      func(z *Int) (*Int, error) { return z.CheckedAdd(MaxInt256(), NewInt(1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Should return correct value when subtracting down to min int256",
+			op:   func(z *Int) (*Int, error) { return z.CheckedSub(NewInt(-1), MaxInt256()) },
+			want: MinInt256(),
+		},
+		{
+			name:    "Should return overflow error when subtracting past min int256",
+			op:      func(z *Int) (*Int, error) { return z.CheckedSub(MinInt256(), NewInt(1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Should return correct value when multiplying in range",
+			op:   func(z *Int) (*Int, error) { return z.CheckedMul(NewInt(-4), NewInt(5)) },
+			want: NewInt(-20),
+		},
+		{
+			name:    "Should return overflow error when multiplying min int256 by -1",
+			op:      func(z *Int) (*Int, error) { return z.CheckedMul(MinInt256(), NewInt(-1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Should return correct value when dividing",
+			op:   func(z *Int) (*Int, error) { return z.CheckedQuo(NewInt(-7), NewInt(2)) },
+			want: NewInt(-3),
+		},
+		{
+			name:    "Should return division by zero error when dividing by zero",
+			op:      func(z *Int) (*Int, error) { return z.CheckedQuo(NewInt(7), NewInt(0)) },
+			wantErr: ErrDivisionByZero,
+		},
+		{
+			name:    "Should return overflow error when dividing min int256 by -1",
+			op:      func(z *Int) (*Int, error) { return z.CheckedQuo(MinInt256(), NewInt(-1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Should return correct value when taking the remainder",
+			op:   func(z *Int) (*Int, error) { return z.CheckedRem(NewInt(-7), NewInt(2)) },
+			want: NewInt(-1),
+		},
+		{
+			name:    "Should return division by zero error when taking the remainder by zero",
+			op:      func(z *Int) (*Int, error) { return z.CheckedRem(NewInt(7), NewInt(0)) },
+			wantErr: ErrDivisionByZero,
+		},
+		{
+			name: "Should return correct value when negating max int256",
+			op:   func(z *Int) (*Int, error) { return z.CheckedNeg(MaxInt256()) },
+			want: new(Int).Add(MinInt256(), NewInt(1)),
+		},
+		{
+			name: "Should return correct value for Euclidean division",
+			op:   func(z *Int) (*Int, error) { return z.CheckedDiv(NewInt(-10), NewInt(3)) },
+			want: NewInt(-4),
+		},
+		{
+			name:    "Should return overflow error when Euclidean dividing min int256 by -1",
+			op:      func(z *Int) (*Int, error) { return z.CheckedDiv(MinInt256(), NewInt(-1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "Should return division by zero error for Euclidean division",
+			op:      func(z *Int) (*Int, error) { return z.CheckedDiv(NewInt(1), New()) },
+			wantErr: ErrDivisionByZero,
+		},
+		{
+			name: "Should return non-negative Euclidean modulus",
+			op:   func(z *Int) (*Int, error) { return z.CheckedMod(NewInt(-10), NewInt(-3)) },
+			want: NewInt(2),
+		},
+		{
+			name:    "Should return division by zero error for Euclidean modulus",
+			op:      func(z *Int) (*Int, error) { return z.CheckedMod(NewInt(1), nil) },
+			wantErr: ErrDivisionByZero,
+		},
+		{
+			name:    "Should return overflow error when negating min int256",
+			op:      func(z *Int) (*Int, error) { return z.CheckedNeg(MinInt256()) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Should return correct value when the power is in range",
+			op:   func(z *Int) (*Int, error) { return z.CheckedExp(NewInt(-3), NewInt(3)) },
+			want: NewInt(-27),
+		},
+		{
+			name:    "Should return overflow error when the power is out of range",
+			op:      func(z *Int) (*Int, error) { return z.CheckedExp(NewInt(2), NewInt(255)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "Should return negative exponent error when the exponent is negative",
+			op:      func(z *Int) (*Int, error) { return z.CheckedExp(NewInt(2), NewInt(-1)) },
+			wantErr: ErrNegativeExponent,
+		},
+		{
+			name:    "Should return negative exponent error for a base of one",
+			op:      func(z *Int) (*Int, error) { return z.CheckedExp(NewInt(1), MinInt256()) },
+			wantErr: ErrNegativeExponent,
+		},
+		{
+			name: "Should return one when the exponent is zero",
+			op:   func(z *Int) (*Int, error) { return z.CheckedExp(MinInt256(), New()) },
+			want: NewInt(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := NewInt(42)
+			got, err := tt.op(z)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if got != nil || !reflect.DeepEqual(z, NewInt(42)) {
+					t.Errorf("got %v and z = %v, want nil and z unchanged", got, z)
+				}
+				return
+			}
+			if got != z || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
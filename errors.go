@@ -0,0 +1,24 @@
+package int256
+
+import "errors"
+
+// ErrOverflow is returned when a value does not fit in the int256 range.
+var ErrOverflow = errors.New("int256: value out of range")
+
+// ErrDivisionByZero is returned when dividing by zero.
+var ErrDivisionByZero = errors.New("int256: division by zero")
+
+// ErrNegativeExponent is returned when raising to a negative power without
+// a modulus, which has no integer result.
+var ErrNegativeExponent = errors.New("int256: negative exponent")
+
+// ErrSyntax is returned when a string is not a valid number in the
+// requested base.
+var ErrSyntax = errors.New("int256: invalid syntax")
+
+// ErrPrecision is returned when a decimal amount has more fractional digits
+// than the requested number of decimals.
+var ErrPrecision = errors.New("int256: too many decimal places")
+
+// ErrInvalidLength is returned when binary input is too short to decode.
+var ErrInvalidLength = errors.New("int256: invalid input length")
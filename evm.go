@@ -0,0 +1,42 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// SDiv sets z to the quotient x/y rounded toward zero and returns z,
+// following the EVM SDIV opcode: if y == 0 the result is 0, and
+// MinInt256 / -1 wraps around to MinInt256.
+func (z *Int) SDiv(x, y *Int) *Int {
+	if y.IsZero() {
+		return z.SetUint64(0)
+	}
+	return z.Quo(x, y)
+}
+
+// SMod sets z to the remainder x%y and returns z, following the EVM SMOD
+// opcode: the result takes the sign of x, and if y == 0 the result is 0.
+func (z *Int) SMod(x, y *Int) *Int {
+	if y.IsZero() {
+		return z.SetUint64(0)
+	}
+	return z.Rem(x, y)
+}
+
+// SignExtend sets z to x with the sign bit of its low b+1 bytes extended
+// through all 256 bits, and returns z, following the EVM SIGNEXTEND opcode.
+// If b > 30, z is set to x.
+func (z *Int) SignExtend(x *Int, b uint) *Int {
+	var w, n uint256.Int
+	x.toWord(&w)
+	n.SetUint64(uint64(b))
+	return z.setWord(w.ExtendSign(&w, &n))
+}
+
+// Slt reports whether z < x, following the EVM SLT opcode.
+func (z *Int) Slt(x *Int) bool {
+	return z.Cmp(x) < 0
+}
+
+// Sgt reports whether z > x, following the EVM SGT opcode.
+func (z *Int) Sgt(x *Int) bool {
+	return z.Cmp(x) > 0
+}
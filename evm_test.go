@@ -0,0 +1,188 @@
+package int256
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInt_SDiv(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should round toward zero when performing a negative and a positive number",
+			args: args{
+				x: NewInt(-7),
+				y: NewInt(2),
+			},
+			want: NewInt(-3),
+		},
+		{
+			name: "Should return zero when dividing by zero",
+			args: args{
+				x: NewInt(7),
+				y: NewInt(0),
+			},
+			want: NewInt(0),
+		},
+		{
+			name: "Should wrap around when dividing min int256 by -1",
+			args: args{
+				x: MinInt256(),
+				y: NewInt(-1),
+			},
+			want: MinInt256(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).SDiv(tt.args.x, tt.args.y); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.SDiv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_SMod(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should take the sign of x when x is negative",
+			args: args{
+				x: NewInt(-7),
+				y: NewInt(3),
+			},
+			want: NewInt(-1),
+		},
+		{
+			name: "Should take the sign of x when y is negative",
+			args: args{
+				x: NewInt(7),
+				y: NewInt(-3),
+			},
+			want: NewInt(1),
+		},
+		{
+			name: "Should return zero when y is zero",
+			args: args{
+				x: NewInt(-7),
+				y: NewInt(0),
+			},
+			want: NewInt(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).SMod(tt.args.x, tt.args.y); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.SMod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_SignExtend(t *testing.T) {
+	type args struct {
+		x *Int
+		b uint
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should extend the sign when the sign bit of the low byte is set",
+			args: args{
+				x: NewInt(0xff),
+				b: 0,
+			},
+			want: NewInt(-1),
+		},
+		{
+			name: "Should clear the high bits when the sign bit of the low byte is not set",
+			args: args{
+				x: NewInt(0x17f),
+				b: 0,
+			},
+			want: NewInt(0x7f),
+		},
+		{
+			name: "Should extend the sign from the second byte",
+			args: args{
+				x: NewInt(0x8000),
+				b: 1,
+			},
+			want: NewInt(-0x8000),
+		},
+		{
+			name: "Should return x when b is greater than 30",
+			args: args{
+				x: NewInt(-0x8000),
+				b: 31,
+			},
+			want: NewInt(-0x8000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).SignExtend(tt.args.x, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.SignExtend() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_SltSgt(t *testing.T) {
+	tests := []struct {
+		name    string
+		z       *Int
+		x       *Int
+		wantSlt bool
+		wantSgt bool
+	}{
+		{
+			name:    "Should return correct value when z is negative and x is positive",
+			z:       NewInt(-1),
+			x:       NewInt(1),
+			wantSlt: true,
+			wantSgt: false,
+		},
+		{
+			name:    "Should return correct value when z is max int256 and x is min int256",
+			z:       MaxInt256(),
+			x:       MinInt256(),
+			wantSlt: false,
+			wantSgt: true,
+		},
+		{
+			name:    "Should return correct value when z equals x",
+			z:       NewInt(-5),
+			x:       NewInt(-5),
+			wantSlt: false,
+			wantSgt: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.z.Slt(tt.x); got != tt.wantSlt {
+				t.Errorf("Int.Slt() = %v, want %v", got, tt.wantSlt)
+			}
+			if got := tt.z.Sgt(tt.x); got != tt.wantSgt {
+				t.Errorf("Int.Sgt() = %v, want %v", got, tt.wantSgt)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/linhbkhn95/int256"
@@ -11,6 +12,14 @@ type PoolState struct {
 	Tick  int64
 }
 
+// PoolStateDTO is the wire form of PoolState for browser clients, which
+// would lose precision decoding Price as a bare JSON number.
+type PoolStateDTO struct {
+	Price    *int256.String `json:"price"`
+	PriceHex *int256.Hex    `json:"priceHex"`
+	Tick     int64          `json:"tick"`
+}
+
 func main() {
 	state1 := &PoolState{
 		Price: fromString("100000000000000000000000"),
@@ -23,10 +32,20 @@ func main() {
 
 	fmt.Println("state1", state1)
 	fmt.Println("state2", state2)
+	fmt.Printf("state2 price: %#x\n", state2.Price)
+
+	data, _ := json.Marshal(state2)
+	fmt.Println("state2 json", string(data))
 
+	data, _ = json.Marshal(PoolStateDTO{
+		Price:    (*int256.String)(state2.Price),
+		PriceHex: (*int256.Hex)(state2.Price),
+		Tick:     state2.Tick,
+	})
+	fmt.Println("state2 dto json", string(data))
 }
 
 func fromString(str string) *int256.Int {
-	i, _ := new(int256.Int).SetString(str)
+	i, _ := new(int256.Int).SetString(str, 10)
 	return i
 }
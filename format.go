@@ -0,0 +1,218 @@
+package int256
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/holiman/uint256"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 62, inclusive. The result uses the
+// lower-case letters 'a' to 'z' for digit values 10 to 35, and the
+// upper-case letters 'A' to 'Z' for digit values 36 to 61.
+// No prefix (such as "0x") is added to the string.
+func (z *Int) Text(base int) string {
+	// A sign and the 256 binary digits of 2^256-1 fit on the stack.
+	var buf [257]byte
+	return string(z.Append(buf[:0], base))
+}
+
+// Append appends the string representation of z, as generated by
+// z.Text(base), to buf and returns the extended buffer.
+func (z *Int) Append(buf []byte, base int) []byte {
+	z = operand(z)
+	if z.neg {
+		buf = append(buf, '-')
+	}
+	return appendAbs(buf, z.abs, base)
+}
+
+// Hex returns the hexadecimal representation of z with a "0x" prefix and,
+// for negative values, a leading minus sign, e.g. "-0x2a".
+func (z *Int) Hex() string {
+	var buf [67]byte
+	s := buf[:0]
+	z = operand(z)
+	if z.neg {
+		s = append(s, '-')
+	}
+	s = append(s, '0', 'x')
+	return string(appendAbs(s, z.abs, 16))
+}
+
+// Format implements fmt.Formatter. It accepts the formats
+// 'b' (binary), 'o' (octal with 0 prefix), 'O' (octal with 0o prefix),
+// 'd' (decimal), 'x' (lowercase hexadecimal), and
+// 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification. The output matches that of *big.Int.
+func (z *Int) Format(s fmt.State, ch rune) {
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o', 'O':
+		base = 8
+	case 'd', 's', 'v':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	default:
+		// unknown format
+		_, _ = fmt.Fprintf(s, "%%!%c(int256.Int=%s)", ch, z.String())
+		return
+	}
+	z = operand(z)
+
+	// determine sign character
+	sign := ""
+	switch {
+	case z.neg:
+		sign = "-"
+	case s.Flag('+'): // supersedes ' ' when both specified
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	// determine prefix characters for indicating output base
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b':
+			prefix = "0b"
+		case 'o':
+			prefix = "0"
+		case 'x':
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	var buf [256]byte
+	digits := appendAbs(buf[:0], z.abs, base)
+	if ch == 'X' {
+		for i, d := range digits {
+			if 'a' <= d && d <= 'z' {
+				digits[i] = 'A' + (d - 'a')
+			}
+		}
+	}
+
+	// number of characters for the three classes of number padding
+	var left int  // space characters to left of digits for right justification ("%8d")
+	var zeros int // zero characters as left-most digits ("%.8d")
+	var right int // space characters to right of digits for left justification ("%-8d")
+
+	// determine number padding from precision: the least number of digits to output
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits)
+		case len(digits) == 1 && digits[0] == '0' && precision == 0:
+			return // print nothing if zero value (z == 0) and zero precision ("." or ".0")
+		}
+	}
+
+	// determine field pad from width: the least number of characters to output
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width {
+		switch d := width - length; {
+		case s.Flag('-'):
+			// pad on the right with spaces; supersedes '0' when both specified
+			right = d
+		case s.Flag('0') && !precisionSet:
+			// pad with zeros unless precision also specified
+			zeros = d
+		default:
+			// pad on the left with spaces
+			left = d
+		}
+	}
+
+	// print number as [left pad][sign][prefix][zero pad][digits][right pad]
+	writeMultiple(s, " ", left)
+	_, _ = io.WriteString(s, sign)
+	_, _ = io.WriteString(s, prefix)
+	writeMultiple(s, "0", zeros)
+	_, _ = s.Write(digits)
+	writeMultiple(s, " ", right)
+}
+
+// writeMultiple writes count copies of text to s.
+func writeMultiple(s fmt.State, text string, count int) {
+	for i := 0; i < count; i++ {
+		_, _ = io.WriteString(s, text)
+	}
+}
+
+// appendAbs appends the representation of x in the given base to dst and
+// returns the extended buffer. It panics if base is not in [2, MaxBase].
+func appendAbs(dst []byte, x *uint256.Int, base int) []byte {
+	if base < 2 || base > MaxBase {
+		panic("invalid base")
+	}
+	b := uint64(base)
+	if x.IsUint64() {
+		return appendUint64(dst, x.Uint64(), b, 0)
+	}
+
+	// Peel off chunks of n digits from the right using the largest power
+	// of the base that fits in a uint64, then print the leading chunk as is
+	// and the others zero-padded to n digits. Each chunk holds at least 58
+	// bits, so five chunks cover 256 bits for every base.
+	bn, n := b, 1
+	for bn <= ^uint64(0)/b {
+		bn *= b
+		n++
+	}
+	var (
+		q, r, d uint256.Int
+		chunks  [5]uint64
+		k       int
+	)
+	d.SetUint64(bn)
+	q.Set(x)
+	for !q.IsUint64() {
+		q.DivMod(&q, &d, &r)
+		chunks[k] = r.Uint64()
+		k++
+	}
+	dst = appendUint64(dst, q.Uint64(), b, 0)
+	for k--; k >= 0; k-- {
+		dst = appendUint64(dst, chunks[k], b, n)
+	}
+	return dst
+}
+
+// appendUint64 appends the digits of x in base b to dst, zero-padded on the
+// left to at least width digits, and returns the extended buffer.
+func appendUint64(dst []byte, x, b uint64, width int) []byte {
+	var buf [64]byte
+	i := len(buf)
+	for x >= b {
+		i--
+		buf[i] = digits[x%b]
+		x /= b
+	}
+	i--
+	buf[i] = digits[x]
+	for len(buf)-i < width {
+		i--
+		buf[i] = '0'
+	}
+	return append(dst, buf[i:]...)
+}
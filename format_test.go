@@ -0,0 +1,111 @@
+package int256
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestInt_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *Int
+		base int
+		want string
+	}{
+		{name: "Should format zero", x: New(), base: 16, want: "0"},
+		{name: "Should format negative hex", x: NewInt(-255), base: 16, want: "-ff"},
+		{name: "Should format binary", x: NewInt(5), base: 2, want: "101"},
+		{name: "Should format base 62", x: NewInt(3817), base: 62, want: "Zz"},
+		{name: "Should format min int256 in hex", x: MinInt256(), base: 16, want: "-8000000000000000000000000000000000000000000000000000000000000000"},
+		{name: "Should format nil as zero", x: nil, base: 10, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.Text(tt.base); got != tt.want {
+				t.Errorf("Int.Text(%d) = %v, want %v", tt.base, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_TextMatchesBig(t *testing.T) {
+	for _, x := range boundaryValues() {
+		for base := 2; base <= MaxBase; base++ {
+			if got, want := MustFromBig(x).Text(base), x.Text(base); got != want {
+				t.Errorf("Int.Text(%d) = %v, want %v", base, got, want)
+			}
+		}
+	}
+}
+
+func TestInt_TextInvalidBase(t *testing.T) {
+	for _, base := range []int{0, 1, MaxBase + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Int.Text(%d) did not panic", base)
+				}
+			}()
+			NewInt(1).Text(base)
+		}()
+	}
+}
+
+func TestInt_Append(t *testing.T) {
+	buf := []byte("x=")
+	if got := string(NewInt(-42).Append(buf, 16)); got != "x=-2a" {
+		t.Errorf("Int.Append() = %v, want x=-2a", got)
+	}
+}
+
+func TestInt_Hex(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *Int
+		want string
+	}{
+		{name: "Should format zero", x: New(), want: "0x0"},
+		{name: "Should format positive value", x: NewInt(42), want: "0x2a"},
+		{name: "Should format negative value", x: NewInt(-42), want: "-0x2a"},
+		{name: "Should format max int256", x: MaxInt256(), want: "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+		{name: "Should format min int256", x: MinInt256(), want: "-0x8000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.Hex(); got != tt.want {
+				t.Errorf("Int.Hex() = %v, want %v", got, tt.want)
+			}
+			if got, err := FromHex(tt.want); err != nil || got.Cmp(tt.x) != 0 {
+				t.Errorf("FromHex(%v) = %v, %v, want %v", tt.want, got, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestInt_FormatMatchesBig(t *testing.T) {
+	formats := []string{
+		"%d", "%v", "%s", "%x", "%X", "%o", "%O", "%b", "%+d", "% d", "%+x",
+		"%08d", "%-8d|", "%8x", "%#x", "%#X", "%#o", "%#b", "%#08x", "%.5d",
+		"%10.5d", "%.0d", "%.d", "%-#10x|", "%+08d", "%q", "%f",
+	}
+	values := append(boundaryValues(), big.NewInt(0), big.NewInt(42), big.NewInt(-42))
+	for _, x := range values {
+		for _, f := range formats {
+			got := fmt.Sprintf(f, MustFromBig(x))
+			want := fmt.Sprintf(f, x)
+			if f == "%q" || f == "%f" {
+				want = fmt.Sprintf("%%!%c(int256.Int=%s)", f[1], x)
+			}
+			if got != want {
+				t.Errorf("fmt.Sprintf(%q, %v) = %q, want %q", f, x, got, want)
+			}
+		}
+	}
+}
+
+func TestInt256_Format(t *testing.T) {
+	if got := fmt.Sprintf("%#x", NewInt256(-42)); got != "-0x2a" {
+		t.Errorf("fmt.Sprintf(%%#x, Int256) = %v, want -0x2a", got)
+	}
+}
@@ -1,14 +1,19 @@
 package int256
 
 import (
-	"math/big"
-
 	"github.com/holiman/uint256"
 )
 
 var one = uint256.NewInt(1)
-var maxUint256 = uint256.MustFromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+var minInt256Abs = new(uint256.Int).Lsh(one, 255)
 
+// Int represents a signed 256-bit integer with the semantics of Solidity's
+// int256: it holds values in [-2^255, 2^255-1], and arithmetic whose exact
+// result falls outside that range wraps around in two's complement, exactly
+// like the EVM does.
+//
+// As with math/big, methods of the form z.Op(x, y) store the result in the
+// receiver z and return it, and z may be the same Int as any operand.
 type Int struct {
 	abs *uint256.Int
 	neg bool
@@ -20,7 +25,8 @@ type Int struct {
 //	 0 if x == 0
 //	+1 if x >  0
 func (z *Int) Sign() int {
-	if len(z.abs) == 0 {
+	z = operand(z)
+	if z.IsZero() {
 		return 0
 	}
 	if z.neg {
@@ -29,6 +35,11 @@ func (z *Int) Sign() int {
 	return 1
 }
 
+// IsZero reports whether z == 0.
+func (z *Int) IsZero() bool {
+	return operand(z).abs.IsZero()
+}
+
 func New() *Int {
 	return &Int{
 		abs: new(uint256.Int),
@@ -65,112 +76,102 @@ func NewInt(x int64) *Int {
 	return New().SetInt64(x)
 }
 
-// SetUint64 sets z to x and returns z.
-func (z *Int) SetString(s string) (*Int, error) {
-	origin := s
-	neg := false
-	// Remove max one leading +
-	if len(s) > 0 && s[0] == '+' {
-		neg = false
-		s = s[1:]
-	}
-
-	if len(s) > 0 && s[0] == '-' {
-		neg = true
-		s = s[1:]
-	}
-	var (
-		abs *uint256.Int
-		err error
-	)
-	abs, err = uint256.FromDecimal(s)
-	if err != nil {
-		// TODO: parse base as input param
-		b, ok := new(big.Int).SetString(origin, 16)
-		if !ok {
-			return nil, err
-		}
-		return MustFromBig(b), nil
+// MaxInt256 allocates and returns a new Int set to 2^255-1, the largest
+// int256 value.
+func MaxInt256() *Int {
+	return &Int{
+		abs: new(uint256.Int).Sub(minInt256Abs, one),
 	}
+}
 
+// MinInt256 allocates and returns a new Int set to -2^255, the smallest
+// int256 value.
+func MinInt256() *Int {
 	return &Int{
-		abs,
-		neg,
-	}, nil
-}
-
-// // setFromScanner implements SetString given an io.ByteScanner.
-// // For documentation see comments of SetString.
-// func (z *Int) setFromScanner(r io.ByteScanner, base int) (*Int, bool) {
-// 	if _, _, err := z.scan(r, base); err != nil {
-// 		return nil, false
-// 	}
-// 	// entire content must have been consumed
-// 	if _, err := r.ReadByte(); err != io.EOF {
-// 		return nil, false
-// 	}
-// 	return z, true // err == io.EOF => scan consumed all content of r
-// }
+		abs: new(uint256.Int).Set(minInt256Abs),
+		neg: true,
+	}
+}
 
-func (z *Int) Add(x, y *Int) *Int {
-	z.initiateAbs()
+// Set sets z to x and returns z.
+func (z *Int) Set(x *Int) *Int {
+	x = operand(x)
+	return z.setAbs(x.abs, x.neg)
+}
 
-	neg := x.neg
+// SetString sets z to the value of s, interpreted in the given base, and
+// returns z and a nil error. It accepts the same inputs as big.Int.SetString:
+// an optional sign "+" or "-" followed by digits in the given base.
+//
+// The base argument must be 0 or a value between 2 and MaxBase. For base 0,
+// the number prefix determines the actual base: a prefix of "0b" or "0B"
+// selects base 2, "0", "0o" or "0O" selects base 8, and "0x" or "0X"
+// selects base 16. Otherwise, the selected base is 10 and no prefix is
+// accepted. For base 0, an underscore character "_" may appear between a
+// base prefix and an adjacent digit, and between successive digits, as in
+// Go integer literals.
+//
+// For bases <= 36, lower and upper case letters are considered the same:
+// the letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit
+// values 36 to 61.
+//
+// If s is not a valid number, SetString returns nil and ErrSyntax. If s is
+// valid but outside [-2^255, 2^255-1], it returns nil and ErrOverflow.
+// In both cases z is left unchanged.
+func (z *Int) SetString(s string, base int) (*Int, error) {
+	neg, s := cutSign(s)
+	return z.scan(s, neg, base)
+}
 
-	if x.neg == y.neg {
-		// x + y == x + y
-		// (-x) + (-y) == -(x + y)
-		z.abs = z.abs.Add(x.abs, y.abs)
-	} else {
-		// x + (-y) == x - y == -(y - x)
-		// (-x) + y == y - x == -(x - y)
-		if x.abs.Cmp(y.abs) >= 0 {
-			z.abs = z.abs.Sub(x.abs, y.abs)
-		} else {
-			neg = !neg
-			z.abs = z.abs.Sub(y.abs, x.abs)
-		}
-	}
-	z.neg = neg // 0 has no sign
-	return z
+// Add sets z to the sum x+y and returns z.
+// The sum wraps around on overflow, like the EVM ADD opcode.
+func (z *Int) Add(x, y *Int) *Int {
+	var a, b uint256.Int
+	x.toWord(&a)
+	y.toWord(&b)
+	return z.setWord(a.Add(&a, &b))
 }
 
 // Sub sets z to the difference x-y and returns z.
+// The difference wraps around on overflow, like the EVM SUB opcode.
 func (z *Int) Sub(x, y *Int) *Int {
-	z.initiateAbs()
-
-	neg := x.neg
-	if x.neg != y.neg {
-		// x - (-y) == x + y
-		// (-x) - y == -(x + y)
-		z.abs = z.abs.Add(x.abs, y.abs)
-	} else {
-		// x - y == x - y == -(y - x)
-		// (-x) - (-y) == y - x == -(x - y)
-		if x.abs.Cmp(y.abs) >= 0 {
-			z.abs = z.abs.Sub(x.abs, y.abs)
-		} else {
-			neg = !neg
-			z.abs = z.abs.Sub(y.abs, x.abs)
-		}
-	}
-	z.neg = neg // 0 has no sign
-	return z
+	var a, b uint256.Int
+	x.toWord(&a)
+	y.toWord(&b)
+	return z.setWord(a.Sub(&a, &b))
 }
 
 // Mul sets z to the product x*y and returns z.
+// The product wraps around on overflow, like the EVM MUL opcode.
 func (z *Int) Mul(x, y *Int) *Int {
-	z.initiateAbs()
+	var a, b uint256.Int
+	x.toWord(&a)
+	y.toWord(&b)
+	return z.setWord(a.Mul(&a, &b))
+}
 
-	z.abs = z.abs.Mul(x.abs, y.abs)
-	z.neg = x.neg != y.neg // 0 has no sign
-	return z
+// Neg sets z to -x and returns z.
+// Negating MinInt256 wraps around to MinInt256, like the EVM.
+func (z *Int) Neg(x *Int) *Int {
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.Neg(&w))
+}
+
+// Abs sets z to |x| (the absolute value of x) and returns z.
+// The absolute value of MinInt256 wraps around to MinInt256, like the EVM.
+func (z *Int) Abs(x *Int) *Int {
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.Abs(&w))
 }
 
 // Sqrt sets z to ⌊√x⌋, the largest integer such that z² ≤ x, and returns z.
 // It panics if x is negative.
 func (z *Int) Sqrt(x *Int) *Int {
 	z.initiateAbs()
+	x = operand(x)
 
 	if x.neg {
 		panic("square root of negative number")
@@ -181,39 +182,116 @@ func (z *Int) Sqrt(x *Int) *Int {
 }
 
 // Rsh sets z = x >> n and returns z.
+// Rsh implements arithmetic shift (rounding toward negative infinity), like
+// the EVM SAR opcode.
 func (z *Int) Rsh(x *Int, n uint) *Int {
-	z.initiateAbs()
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.SRsh(&w, n))
+}
 
-	if !x.neg {
-		z.abs.Rsh(x.abs, n)
-		z.neg = x.neg
-		return z
-	}
-	// TODO: implement
-	b := x.ToBig()
-	return MustFromBig(b.Rsh(b, n))
+// LogicalRsh sets z to the two's-complement encoding of x shifted right by
+// n bits, filling the vacated high bits with zeros, and returns z. It is the
+// logical counterpart of Rsh, like the EVM SHR opcode, so for negative x and
+// n > 0 the result is non-negative.
+func (z *Int) LogicalRsh(x *Int, n uint) *Int {
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.Rsh(&w, n))
 }
 
 // Quo sets z to the quotient x/y for y != 0 and returns z.
 // If y == 0, a division-by-zero run-time panic occurs.
 // Quo implements truncated division (like Go); see QuoRem for more details.
 func (z *Int) Quo(x, y *Int) *Int {
-	z.initiateAbs()
-
-	z.abs = z.abs.Div(x.abs, y.abs)
-	z.neg = len(z.abs) > 0 && x.neg != y.neg // 0 has no sign
-	return z
+	x, y = operand(x), operand(y)
+	if y.abs.IsZero() {
+		panic("division by zero")
+	}
+	var q uint256.Int
+	q.Div(x.abs, y.abs)
+	// MinInt256 / -1 wraps around to MinInt256.
+	return z.setAbs(&q, x.neg != y.neg).wrap()
 }
 
 // Rem sets z to the remainder x%y for y != 0 and returns z.
 // If y == 0, a division-by-zero run-time panic occurs.
 // Rem implements truncated modulus (like Go); see QuoRem for more details.
 func (z *Int) Rem(x, y *Int) *Int {
-	z.initiateAbs()
+	x, y = operand(x), operand(y)
+	if y.abs.IsZero() {
+		panic("division by zero")
+	}
+	var r uint256.Int
+	r.Mod(x.abs, y.abs)
+	return z.setAbs(&r, x.neg)
+}
 
-	z.abs.Mod(x.abs, y.abs)
-	z.neg = len(z.abs) > 0 && x.neg // 0 has no sign
-	return z
+// QuoRem sets z to the quotient x/y and r to the remainder x%y
+// and returns the pair (z, r) for y != 0.
+// If y == 0, a division-by-zero run-time panic occurs.
+//
+// QuoRem implements T-division and modulus (like Go):
+//
+//	q = x/y      with the result truncated to zero
+//	r = x - y*q
+//
+// (See Daan Leijen, “Division and Modulus for Computer Scientists”.)
+// See DivMod for Euclidean division and modulus (unlike Go).
+func (z *Int) QuoRem(x, y, r *Int) (*Int, *Int) {
+	x, y = operand(x), operand(y)
+	if y.abs.IsZero() {
+		panic("division by zero")
+	}
+	var q, m uint256.Int
+	q.DivMod(x.abs, y.abs, &m)
+	xNeg, yNeg := x.neg, y.neg
+	r.setAbs(&m, xNeg)
+	return z.setAbs(&q, xNeg != yNeg).wrap(), r
+}
+
+// Div sets z to the quotient x/y for y != 0 and returns z.
+// If y == 0, a division-by-zero run-time panic occurs.
+// Div implements Euclidean division (unlike Go); see DivMod for more details.
+// MinInt256 div -1 wraps around to MinInt256; use DivOverflow or CheckedDiv
+// to detect it.
+func (z *Int) Div(x, y *Int) *Int {
+	x, y = operand(x), operand(y)
+	var q, m uint256.Int
+	euclidDivMod(&q, &m, x, y)
+	return z.setAbs(&q, x.neg != y.neg).wrap()
+}
+
+// Mod sets z to the modulus x%y for y != 0 and returns z.
+// If y == 0, a division-by-zero run-time panic occurs.
+// Mod implements Euclidean modulus (unlike Go); see DivMod for more details.
+func (z *Int) Mod(x, y *Int) *Int {
+	var q, m uint256.Int
+	euclidDivMod(&q, &m, x, y)
+	return z.setAbs(&m, false)
+}
+
+// DivMod sets z to the quotient x div y and m to the modulus x mod y
+// and returns the pair (z, m) for y != 0.
+// If y == 0, a division-by-zero run-time panic occurs.
+//
+// DivMod implements Euclidean division and modulus (unlike Go):
+//
+//	q = x div y  such that
+//	m = x - y*q  with 0 <= m < |y|
+//
+// (See Raymond T. Boute, “The Euclidean definition of the functions
+// div and mod”. ACM Transactions on Programming Languages and
+// Systems (TOPLAS), 14(2):127-144, New York, NY, USA, 4/1992.
+// ACM press.)
+// See QuoRem for T-division and modulus (like Go).
+func (z *Int) DivMod(x, y, m *Int) (*Int, *Int) {
+	x, y = operand(x), operand(y)
+	var q, r uint256.Int
+	euclidDivMod(&q, &r, x, y)
+	neg := x.neg != y.neg
+	m.setAbs(&r, false)
+	return z.setAbs(&q, neg).wrap(), m
 }
 
 // Cmp compares x and y and returns:
@@ -222,7 +300,7 @@ func (z *Int) Rem(x, y *Int) *Int {
 //	 0 if x == y
 //	+1 if x >  y
 func (z *Int) Cmp(x *Int) (r int) {
-	z.initiateAbs()
+	z, x = operand(z), operand(x)
 
 	// x cmp y == x cmp y
 	// x cmp (-y) == x
@@ -236,8 +314,6 @@ func (z *Int) Cmp(x *Int) (r int) {
 		if z.neg {
 			r = -r
 		}
-	case z.abs.IsZero() && x.abs.IsZero():
-		r = 0
 	case z.neg:
 		r = -1
 	default:
@@ -249,61 +325,64 @@ func (z *Int) Cmp(x *Int) (r int) {
 // Exp sets z = x**y mod |m| (i.e. the sign of m is ignored), and returns z.
 // If m == nil or m == 0, z = x**y unless y <= 0 then z = 1. If m != 0, y < 0,
 // and x and m are not relatively prime, z is unchanged and nil is returned.
+// Without a modulus the power wraps around on overflow, like the EVM EXP
+// opcode; use ExpOverflow to detect it. With a modulus, z is in [0, |m|), and
+// a negative y uses the modular inverse of x.
 //
 // Modular exponentiation of inputs of a particular size is not a
 // cryptographically constant-time operation.
 func (z *Int) Exp(x, y, m *Int) *Int {
-	z.initiateAbs()
-
-	if x == nil {
-		panic("x is nil")
-	}
-	if !x.neg && !y.neg && m == nil {
-		z.neg = false
-		z.abs.Exp(x.abs, y.abs)
-		return z
-	}
-	// TODO: implement
-	var mBigInt *big.Int
-	if m != nil {
-		mBigInt = m.ToBig()
+	x, y = operand(x), operand(y)
+	if m == nil || m.IsZero() {
+		if y.neg {
+			return z.SetUint64(1)
+		}
+		var b uint256.Int
+		x.toWord(&b)
+		return z.setWord(b.Exp(&b, y.abs))
 	}
-	big := new(big.Int).Exp(x.ToBig(), y.ToBig(), mBigInt)
-	z, _ = FromBig(big)
-	return z
-}
 
-func (z *Int) Div(x, y *Int) *Int {
-	z.initiateAbs()
-
-	z.abs.Div(x.abs, y.abs)
-	if x.neg == y.neg {
-		z.neg = false
+	// As in math/big, a negative y raises the inverse of x to |y|, and the
+	// sign of x is then applied to the power before reducing it into
+	// [0, |m|).
+	var base uint256.Int
+	if y.neg {
+		var q uint256.Int
+		euclidDivMod(&q, &base, x, m)
+		if !modInverse(&base, &base, m.abs) {
+			return nil
+		}
 	} else {
-		z.neg = true
+		base.Set(x.abs)
 	}
-	return z
+	expMod(&base, &base, y.abs, m.abs)
+	if x.neg && y.abs[0]&1 == 1 && !base.IsZero() {
+		base.Sub(m.abs, &base)
+	}
+	return z.setAbs(&base, false)
 }
 
 // Lsh sets z = x << n and returns z.
+// Bits shifted beyond bit 255 are discarded, like the EVM SHL opcode, so the
+// result wraps around in two's complement; use LshOverflow to detect it.
 func (z *Int) Lsh(x *Int, n uint) *Int {
-	z.initiateAbs()
-	b := new(big.Int).Lsh(x.abs.ToBig(), n)
-	z.abs = uint256.MustFromBig(b)
-	z.neg = x.neg
-	return z
+	var w uint256.Int
+	x.toWord(&w)
+	return z.setWord(w.Lsh(&w, n))
 }
 
 // Or sets z = x | y and returns z.
 func (z *Int) Or(x, y *Int) *Int {
 	z.initiateAbs()
+	x, y = operand(x), operand(y)
 
 	if x.neg == y.neg {
 		if x.neg {
 			// (-x) | (-y) == ^(x-1) | ^(y-1) == ^((x-1) & (y-1)) == -(((x-1) & (y-1)) + 1)
-			x1 := new(uint256.Int).Sub(x.abs, one)
-			y1 := new(uint256.Int).Sub(y.abs, one)
-			z.abs = z.abs.Add(z.abs.And(x1, y1), one)
+			var x1, y1 uint256.Int
+			x1.Sub(x.abs, one)
+			y1.Sub(y.abs, one)
+			z.abs = z.abs.Add(z.abs.And(&x1, &y1), one)
 			z.neg = true // z cannot be zero if x and y are negative
 			return z
 		}
@@ -320,8 +399,10 @@ func (z *Int) Or(x, y *Int) *Int {
 	}
 
 	// x | (-y) == x | ^(y-1) == ^((y-1) &^ x) == -(^((y-1) &^ x) + 1)
-	y1 := new(uint256.Int).Sub(y.abs, one)
-	z.abs = z.abs.Add(z.abs.And(y1, new(uint256.Int).Xor(x.abs, maxUint256)), one)
+	var y1, notX uint256.Int
+	y1.Sub(y.abs, one)
+	notX.Not(x.abs)
+	z.abs = z.abs.Add(z.abs.And(&y1, &notX), one)
 	z.neg = true // z cannot be zero if one of x or y is negative
 
 	return z
@@ -330,13 +411,15 @@ func (z *Int) Or(x, y *Int) *Int {
 // And sets z = x & y and returns z.
 func (z *Int) And(x, y *Int) *Int {
 	z.initiateAbs()
+	x, y = operand(x), operand(y)
 
 	if x.neg == y.neg {
 		if x.neg {
 			// (-x) & (-y) == ^(x-1) & ^(y-1) == ^((x-1) | (y-1)) == -(((x-1) | (y-1)) + 1)
-			x1 := new(uint256.Int).Sub(x.abs, one)
-			y1 := new(uint256.Int).Sub(y.abs, one)
-			z.abs = z.abs.Add(z.abs.Or(x1, y1), one)
+			var x1, y1 uint256.Int
+			x1.Sub(x.abs, one)
+			y1.Sub(y.abs, one)
+			z.abs = z.abs.Add(z.abs.Or(&x1, &y1), one)
 			z.neg = true // z cannot be zero if x and y are negative
 			return z
 		}
@@ -353,13 +436,26 @@ func (z *Int) And(x, y *Int) *Int {
 	}
 
 	// x & (-y) == x & ^(y-1) == x &^ (y-1)
-	y1 := new(uint256.Int).Sub(y.abs, one)
-	z.abs = z.abs.And(x.abs, new(uint256.Int).Xor(y1, maxUint256))
+	var notY1 uint256.Int
+	notY1.Not(notY1.Sub(y.abs, one))
+	z.abs = z.abs.And(x.abs, &notY1)
 	z.neg = false
 
 	return z
 }
 
+// zeroInt is the read-only zero that operand substitutes for nil operands.
+var zeroInt = Int{abs: new(uint256.Int)}
+
+// operand returns x, or a read-only zero if x is nil or the zero value of
+// Int, so that methods can read their operands without nil checks.
+func operand(x *Int) *Int {
+	if x == nil || x.abs == nil {
+		return &zeroInt
+	}
+	return x
+}
+
 // initiateAbs sets default value for `z.abs` value if is nil
 func (z *Int) initiateAbs() {
 	if z.abs == nil {
@@ -367,3 +463,69 @@ func (z *Int) initiateAbs() {
 	}
 
 }
+
+// toWord writes the two's-complement encoding of z into w and returns w.
+func (z *Int) toWord(w *uint256.Int) *uint256.Int {
+	z = operand(z)
+	if z.neg {
+		return w.Neg(z.abs)
+	}
+	return w.Set(z.abs)
+}
+
+// setWord sets z to the int256 value whose two's-complement encoding is w,
+// and returns z.
+func (z *Int) setWord(w *uint256.Int) *Int {
+	z.initiateAbs()
+
+	z.neg = w.Sign() < 0
+	if z.neg {
+		z.abs.Neg(w)
+	} else {
+		z.abs.Set(w)
+	}
+	return z
+}
+
+// wrap reduces z into the int256 range, wrapping around in two's complement,
+// and returns z.
+func (z *Int) wrap() *Int {
+	var w uint256.Int
+	return z.setWord(z.toWord(&w))
+}
+
+// inRange reports whether the value with magnitude abs and sign neg lies
+// within [-2^255, 2^255-1].
+func inRange(abs *uint256.Int, neg bool) bool {
+	if neg {
+		return abs.Cmp(minInt256Abs) <= 0
+	}
+	return abs.Lt(minInt256Abs)
+}
+
+// setAbs sets z to the value with magnitude abs and sign neg, and returns z.
+// A zero magnitude always yields a non-negative z.
+func (z *Int) setAbs(abs *uint256.Int, neg bool) *Int {
+	z.initiateAbs()
+
+	z.abs.Set(abs)
+	z.neg = neg && !abs.IsZero()
+	return z
+}
+
+// euclidDivMod sets q to the magnitude of the Euclidean quotient of x and y
+// and m to their Euclidean modulus.
+// If y == 0, a division-by-zero run-time panic occurs.
+func euclidDivMod(q, m *uint256.Int, x, y *Int) {
+	x, y = operand(x), operand(y)
+	if y.abs.IsZero() {
+		panic("division by zero")
+	}
+	q.DivMod(x.abs, y.abs, m)
+	if x.neg && !m.IsZero() {
+		// Round the magnitude of the quotient up so that the modulus
+		// becomes non-negative.
+		q.AddUint64(q, 1)
+		m.Sub(y.abs, m)
+	}
+}
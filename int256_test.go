@@ -1,9 +1,12 @@
 package int256
 
 import (
+	"encoding/json"
 	"math/big"
+	"math/rand"
 	"reflect"
 	"testing"
+	"testing/quick"
 
 	"github.com/holiman/uint256"
 )
@@ -106,6 +109,18 @@ func TestInt_Add(t *testing.T) {
 				neg: false,
 			},
 		},
+		{
+			name: "Should wrap around when the sum overflows int256",
+			fields: fields{
+				abs: uint256.NewInt(0),
+				neg: false,
+			},
+			args: args{
+				x: MaxInt256(),
+				y: NewInt(1),
+			},
+			want: MinInt256(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -258,6 +273,18 @@ func TestInt_Sub(t *testing.T) {
 				neg: false,
 			},
 		},
+		{
+			name: "Should wrap around when the difference overflows int256",
+			fields: fields{
+				abs: uint256.NewInt(0),
+				neg: false,
+			},
+			args: args{
+				x: MinInt256(),
+				y: NewInt(1),
+			},
+			want: MaxInt256(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -348,6 +375,18 @@ func TestInt_Mul(t *testing.T) {
 				neg: true,
 			},
 		},
+		{
+			name: "Should wrap around when the product overflows int256",
+			fields: fields{
+				abs: uint256.NewInt(0),
+				neg: false,
+			},
+			args: args{
+				x: MinInt256(),
+				y: NewInt(-1),
+			},
+			want: MinInt256(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -427,12 +466,11 @@ func TestInt_SetString(t *testing.T) {
 		neg bool
 	}
 	type args struct {
-		s string
+		s    string
+		base int
 	}
 	big1, _ := new(big.Int).SetString("-10a", 16)
 
-	var MaxUint256, _ = new(big.Int).SetString("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
-
 	big, _ := new(big.Int).SetString("1461446703485210103287273052203988822378723970342", 10)
 
 	tests := []struct {
@@ -450,7 +488,8 @@ func TestInt_SetString(t *testing.T) {
 				neg: false,
 			},
 			args: args{
-				s: "10",
+				s:    "10",
+				base: 10,
 			},
 			want: &Int{
 				abs: uint256.NewInt(10),
@@ -465,7 +504,8 @@ func TestInt_SetString(t *testing.T) {
 				neg: false,
 			},
 			args: args{
-				s: "-10",
+				s:    "-10",
+				base: 10,
 			},
 			want: &Int{
 				abs: uint256.NewInt(10),
@@ -474,13 +514,14 @@ func TestInt_SetString(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Should return error value when parsing incorrect string value",
+			name: "Should return correct value when parsing hex string value",
 			fields: fields{
 				abs: uint256.NewInt(0),
 				neg: false,
 			},
 			args: args{
-				s: "-10a",
+				s:    "-10a",
+				base: 16,
 			},
 			want:    MustFromBig(big1),
 			wantErr: false,
@@ -492,21 +533,49 @@ func TestInt_SetString(t *testing.T) {
 				neg: false,
 			},
 			args: args{
-				s: "1461446703485210103287273052203988822378723970342",
+				s:    "1461446703485210103287273052203988822378723970342",
+				base: 10,
 			},
 			want:    MustFromBig(big),
 			wantErr: false,
 		},
 		{
-			name: "Should return error value when parsing correct string value",
+			name: "Should return error value when parsing value out of int256 range",
+			fields: fields{
+				abs: uint256.NewInt(0),
+				neg: false,
+			},
+			args: args{
+				s:    "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+				base: 16,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Should return error value when parsing decimal value out of int256 range",
 			fields: fields{
 				abs: uint256.NewInt(0),
 				neg: false,
 			},
 			args: args{
-				s: "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+				s:    "57896044618658097711785492504343953926634992332820282019728792003956564819968",
+				base: 10,
 			},
-			want:    MustFromBig(MaxUint256),
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Should return correct value when parsing min int256",
+			fields: fields{
+				abs: uint256.NewInt(0),
+				neg: false,
+			},
+			args: args{
+				s:    "-57896044618658097711785492504343953926634992332820282019728792003956564819968",
+				base: 10,
+			},
+			want:    MinInt256(),
 			wantErr: false,
 		},
 	}
@@ -516,7 +585,7 @@ func TestInt_SetString(t *testing.T) {
 				abs: tt.fields.abs,
 				neg: tt.fields.neg,
 			}
-			got, err := z.SetString(tt.args.s)
+			got, err := z.SetString(tt.args.s, tt.args.base)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Int.SetString() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1010,6 +1079,30 @@ func TestInt_Lsh(t *testing.T) {
 			},
 			want: MustFromBig(new(big.Int).Lsh(big.NewInt(-10), 4)),
 		},
+		{
+			name: "Should wrap around when shifting into the sign bit",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				x: NewInt(1),
+				n: 255,
+			},
+			want: MinInt256(),
+		},
+		{
+			name: "Should discard bits shifted beyond bit 255",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				x: NewInt(-1),
+				n: 256,
+			},
+			want: NewInt(0),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1397,15 +1490,13 @@ func TestInt_Cmp(t *testing.T) {
 			wantR: 1,
 		},
 		{
-			name: "Should return correct value",
+			name: "Should return 0 when comparing zeros",
 			fields: fields{
 				abs: uint256.NewInt(0),
-				neg: true,
+				neg: false,
 			},
 			args: args{
-				x: &Int{
-					abs: uint256.NewInt(0),
-				},
+				x: new(Int).Neg(new(Int).Sub(NewInt(-5), NewInt(-5))),
 			},
 			wantR: 0,
 		},
@@ -1422,3 +1513,404 @@ func TestInt_Cmp(t *testing.T) {
 		})
 	}
 }
+
+func TestInt_DivisionMatchesBig(t *testing.T) {
+	for _, x := range boundaryValues() {
+		for _, y := range boundaryValues() {
+			if y.Sign() == 0 {
+				continue
+			}
+			wantQuo, wantRem := new(big.Int).QuoRem(x, y, new(big.Int))
+			wantDiv, wantMod := new(big.Int).DivMod(x, y, new(big.Int))
+			wantQuo, _ = wrapBig(wantQuo)
+			wantDiv, _ = wrapBig(wantDiv)
+
+			q, r := new(Int).QuoRem(MustFromBig(x), MustFromBig(y), new(Int))
+			if q.ToBig().Cmp(wantQuo) != 0 || r.ToBig().Cmp(wantRem) != 0 {
+				t.Errorf("Int.QuoRem(%v, %v) = %v, %v, want %v, %v", x, y, q, r, wantQuo, wantRem)
+			}
+			d, m := new(Int).DivMod(MustFromBig(x), MustFromBig(y), new(Int))
+			if d.ToBig().Cmp(wantDiv) != 0 || m.ToBig().Cmp(wantMod) != 0 {
+				t.Errorf("Int.DivMod(%v, %v) = %v, %v, want %v, %v", x, y, d, m, wantDiv, wantMod)
+			}
+			if got := new(Int).Quo(MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(wantQuo) != 0 {
+				t.Errorf("Int.Quo(%v, %v) = %v, want %v", x, y, got, wantQuo)
+			}
+			if got := new(Int).Rem(MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(wantRem) != 0 {
+				t.Errorf("Int.Rem(%v, %v) = %v, want %v", x, y, got, wantRem)
+			}
+			if got := new(Int).Div(MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(wantDiv) != 0 {
+				t.Errorf("Int.Div(%v, %v) = %v, want %v", x, y, got, wantDiv)
+			}
+			if got := new(Int).Mod(MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(wantMod) != 0 {
+				t.Errorf("Int.Mod(%v, %v) = %v, want %v", x, y, got, wantMod)
+			}
+		}
+	}
+}
+
+func TestInt_DivisionByZeroPanic(t *testing.T) {
+	ops := map[string]func(){
+		"Quo":    func() { new(Int).Quo(NewInt(1), NewInt(0)) },
+		"Rem":    func() { new(Int).Rem(NewInt(1), NewInt(0)) },
+		"QuoRem": func() { new(Int).QuoRem(NewInt(1), NewInt(0), new(Int)) },
+		"Div":    func() { new(Int).Div(NewInt(1), NewInt(0)) },
+		"Mod":    func() { new(Int).Mod(NewInt(1), NewInt(0)) },
+		"DivMod": func() { new(Int).DivMod(NewInt(1), NewInt(0), new(Int)) },
+	}
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Int.%s() should have panicked", name)
+				}
+			}()
+			op()
+		})
+	}
+}
+
+func TestInt_Div(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should round toward negative infinity when x is negative and y is positive",
+			args: args{
+				x: NewInt(-7),
+				y: NewInt(2),
+			},
+			want: NewInt(-4),
+		},
+		{
+			name: "Should round up when x and y are negative",
+			args: args{
+				x: NewInt(-7),
+				y: NewInt(-2),
+			},
+			want: NewInt(4),
+		},
+		{
+			name: "Should return non-negative zero when the quotient is zero",
+			args: args{
+				x: NewInt(1),
+				y: NewInt(-2),
+			},
+			want: NewInt(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).Div(tt.args.x, tt.args.y); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.Div() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_RshMatchesBig(t *testing.T) {
+	for _, x := range boundaryValues() {
+		for _, n := range []uint{0, 1, 4, 63, 64, 65, 128, 254, 255, 256, 300} {
+			want := new(big.Int).Rsh(x, n)
+			if got := new(Int).Rsh(MustFromBig(x), n); got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.Rsh(%v, %d) = %v, want %v", x, n, got, want)
+			}
+		}
+	}
+}
+
+func TestInt_LogicalRsh(t *testing.T) {
+	type args struct {
+		x *Int
+		n uint
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should return correct value when perform positive number",
+			args: args{
+				x: NewInt(10),
+				n: 1,
+			},
+			want: NewInt(5),
+		},
+		{
+			name: "Should fill with zeros when perform negative number",
+			args: args{
+				x: NewInt(-1),
+				n: 1,
+			},
+			want: MaxInt256(),
+		},
+		{
+			name: "Should return zero when shifting a negative number by 256",
+			args: args{
+				x: NewInt(-1),
+				n: 256,
+			},
+			want: NewInt(0),
+		},
+		{
+			name: "Should return x when shifting by zero",
+			args: args{
+				x: NewInt(-10),
+				n: 0,
+			},
+			want: NewInt(-10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).LogicalRsh(tt.args.x, tt.args.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Int.LogicalRsh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_LshMatchesBig(t *testing.T) {
+	for _, x := range boundaryValues() {
+		for _, n := range []uint{0, 1, 4, 63, 64, 65, 96, 128, 254, 255, 256, 300} {
+			want, wantOverflow := wrapBig(new(big.Int).Lsh(x, n))
+			if got := new(Int).Lsh(MustFromBig(x), n); got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.Lsh(%v, %d) = %v, want %v", x, n, got, want)
+			}
+			got, overflow := new(Int).LshOverflow(MustFromBig(x), n)
+			if got.ToBig().Cmp(want) != 0 || overflow != wantOverflow {
+				t.Errorf("Int.LshOverflow(%v, %d) = %v, %v, want %v, %v", x, n, got, overflow, want, wantOverflow)
+			}
+		}
+	}
+}
+
+func TestInt_ExpMatchesBig(t *testing.T) {
+	exponents := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(255), big.NewInt(-1), big.NewInt(-2), big.NewInt(-3), bigMaxInt256}
+	moduli := []*big.Int{nil, big.NewInt(0), big.NewInt(1), big.NewInt(-1), big.NewInt(7), big.NewInt(-12), bigMaxInt256, bigMinInt256}
+	for _, x := range boundaryValues() {
+		for _, y := range exponents {
+			for _, m := range moduli {
+				if (m == nil || m.Sign() == 0) && y.BitLen() > 8 {
+					// The exact power is too large for math/big.
+					continue
+				}
+				var mInt *Int
+				if m != nil {
+					mInt = MustFromBig(m)
+				}
+				z := NewInt(42)
+				got := z.Exp(MustFromBig(x), MustFromBig(y), mInt)
+				want := new(big.Int).Exp(x, y, m)
+				if want == nil {
+					if got != nil || z.Cmp(NewInt(42)) != 0 {
+						t.Errorf("Int.Exp(%v, %v, %v) = %v, want nil and z unchanged", x, y, m, got)
+					}
+					continue
+				}
+				want, _ = wrapBig(want)
+				if got == nil || got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.Exp(%v, %v, %v) = %v, want %v", x, y, m, got, want)
+				}
+			}
+		}
+	}
+}
+
+// randomInt returns an Int with a random sign and a magnitude drawn below
+// 2^bits, where bits is picked from a fixed table of sizes from 0 to 255
+// that favours small values so that zero results are common.
+func randomInt(r *rand.Rand) *Int {
+	bits := []uint{0, 1, 2, 8, 64, 128, 255}[r.Intn(7)]
+	abs := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), bits))
+	if r.Intn(2) == 0 {
+		abs.Neg(abs)
+	}
+	return MustFromBig(abs)
+}
+
+func TestInt_CanonicalZero(t *testing.T) {
+	ops := map[string]func(z, x, y *Int) *Int{
+		"Add":    (*Int).Add,
+		"Sub":    (*Int).Sub,
+		"Mul":    (*Int).Mul,
+		"And":    (*Int).And,
+		"Or":     (*Int).Or,
+		"Xor":    (*Int).Xor,
+		"AndNot": (*Int).AndNot,
+		"Self":   func(z, x, y *Int) *Int { return z.Sub(x, x) },
+		"Zero":   func(z, x, y *Int) *Int { return z.Mul(x, New()) },
+		"Neg":    func(z, x, y *Int) *Int { return z.Neg(new(Int).Sub(x, x)) },
+		"Quo": func(z, x, y *Int) *Int {
+			if y.IsZero() {
+				return z.Set(y)
+			}
+			return z.Quo(x, y)
+		},
+		"Rem": func(z, x, y *Int) *Int {
+			if y.IsZero() {
+				return z.Set(y)
+			}
+			return z.Rem(x, y)
+		},
+		"Div": func(z, x, y *Int) *Int {
+			if y.IsZero() {
+				return z.Set(y)
+			}
+			return z.Div(x, y)
+		},
+		"Mod": func(z, x, y *Int) *Int {
+			if y.IsZero() {
+				return z.Set(y)
+			}
+			return z.Mod(x, y)
+		},
+		"Rsh": func(z, x, y *Int) *Int { return z.Rsh(x, uint(y.abs[0]%260)) },
+		"Lsh": func(z, x, y *Int) *Int { return z.Lsh(x, uint(y.abs[0]%260)) },
+	}
+	r := rand.New(rand.NewSource(1))
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			property := func(x, y *Int) bool {
+				got := op(New(), x, y)
+				return !got.IsZero() || !got.neg && got.Sign() == 0 && got.String() == "0"
+			}
+			config := &quick.Config{
+				MaxCount: 2000,
+				Rand:     r,
+				Values: func(args []reflect.Value, r *rand.Rand) {
+					for i := range args {
+						args[i] = reflect.ValueOf(randomInt(r))
+					}
+				},
+			}
+			if err := quick.Check(property, config); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestInt_SetStringNegativeZero(t *testing.T) {
+	got, err := new(Int).SetString("-0", 10)
+	if err != nil {
+		t.Fatalf("Int.SetString() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, New()) || got.String() != "0" {
+		t.Errorf("Int.SetString() = %#v, want canonical zero", got)
+	}
+}
+
+func TestInt_Sign(t *testing.T) {
+	tests := []struct {
+		name       string
+		x          *Int
+		want       int
+		wantIsZero bool
+	}{
+		{
+			name:       "Should return 0 when x is zero",
+			x:          NewInt(0),
+			want:       0,
+			wantIsZero: true,
+		},
+		{
+			name: "Should return -1 when x is negative",
+			x:    NewInt(-3),
+			want: -1,
+		},
+		{
+			name: "Should return 1 when x is positive",
+			x:    NewInt(3),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.Sign(); got != tt.want {
+				t.Errorf("Int.Sign() = %v, want %v", got, tt.want)
+			}
+			if got := tt.x.IsZero(); got != tt.wantIsZero {
+				t.Errorf("Int.IsZero() = %v, want %v", got, tt.wantIsZero)
+			}
+		})
+	}
+}
+
+func TestInt_ZeroValue(t *testing.T) {
+	operands := map[string]func() *Int{
+		"zero value": func() *Int { return new(Int) },
+		"nil":        func() *Int { return nil },
+	}
+	// encoding/json writes null for a nil *Int without calling MarshalJSON.
+	wantJSON := map[string]string{"zero value": "0", "nil": "null"}
+	for name, zero := range operands {
+		t.Run(name, func(t *testing.T) {
+			x := zero()
+			if x.Sign() != 0 || !x.IsZero() || x.Int64() != 0 || x.String() != "0" || x.ToBig().Sign() != 0 {
+				t.Errorf("reading %s Int should give 0", name)
+			}
+			if x.BitLen() != 0 || x.TrailingZeroBits() != 0 || x.Bit(3) != 0 {
+				t.Errorf("bits of %s Int should be 0", name)
+			}
+			if b, err := json.Marshal(x); err != nil || string(b) != wantJSON[name] {
+				t.Errorf("json.Marshal() = %s, %v, want %s", b, err, wantJSON[name])
+			}
+			if x.Cmp(NewInt(0)) != 0 || NewInt(0).Cmp(x) != 0 || x.Cmp(zero()) != 0 {
+				t.Errorf("%s Int should compare equal to 0", name)
+			}
+
+			five := NewInt(5)
+			results := map[string]*Int{
+				"Add":    new(Int).Add(x, five),
+				"Sub":    new(Int).Sub(five, x),
+				"Mul":    new(Int).Mul(x, five),
+				"Quo":    new(Int).Quo(x, five),
+				"Rem":    new(Int).Rem(x, five),
+				"Div":    new(Int).Div(x, five),
+				"Mod":    new(Int).Mod(x, five),
+				"Exp":    new(Int).Exp(five, x, nil),
+				"ExpMod": new(Int).Exp(x, five, NewInt(7)),
+				"Lsh":    new(Int).Lsh(x, 3),
+				"Rsh":    new(Int).Rsh(x, 3),
+				"Or":     new(Int).Or(x, five),
+				"And":    new(Int).And(x, five),
+				"Xor":    new(Int).Xor(x, five),
+				"Neg":    new(Int).Neg(x),
+				"Sqrt":   new(Int).Sqrt(x),
+				"Set":    new(Int).Set(x),
+				"MulMod": new(Int).MulMod(x, five, NewInt(7)),
+				"GCD":    new(Int).GCD(nil, nil, x, five),
+			}
+			want := map[string]int64{"Add": 5, "Sub": 5, "Exp": 1, "Or": 5, "Xor": 5, "GCD": 5}
+			for op, got := range results {
+				if got.Int64() != want[op] {
+					t.Errorf("Int.%s() with %s operand = %v, want %v", op, name, got, want[op])
+				}
+			}
+			if got, _ := new(Int).MulDiv(x, five, five, RoundFloor); !got.IsZero() {
+				t.Errorf("Int.MulDiv() with %s operand = %v, want 0", name, got)
+			}
+		})
+	}
+}
+
+func TestInt_ZeroValueReceiver(t *testing.T) {
+	var state struct {
+		Price Int
+	}
+	if state.Price.String() != "0" {
+		t.Errorf("Int.String() = %v, want 0", state.Price.String())
+	}
+	state.Price.Add(&state.Price, NewInt(3))
+	if state.Price.Int64() != 3 {
+		t.Errorf("Int.Add() = %v, want 3", state.Price.String())
+	}
+}
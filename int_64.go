@@ -1,6 +1,7 @@
 package int256
 
 func (z *Int) Int64() int64 {
+	z = operand(z)
 	absUint64 := z.abs.Uint64()
 	if z.neg {
 		return -int64(absUint64)
@@ -8,12 +9,9 @@ func (z *Int) Int64() int64 {
 	return int64(absUint64)
 }
 
+// String returns the decimal representation of z.
 func (z *Int) String() string {
-	z.initiateAbs()
-
-	s := z.abs.ToBig().String()
-	if !z.neg {
-		return s
-	}
-	return "-" + s
+	// A sign and the 78 digits of 2^256-1 fit on the stack.
+	var buf [79]byte
+	return string(z.Append(buf[:0], 10))
 }
@@ -1,6 +1,7 @@
 package int256
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/holiman/uint256"
@@ -46,3 +47,17 @@ func TestInt_Int64(t *testing.T) {
 		})
 	}
 }
+
+func TestInt_StringMatchesBig(t *testing.T) {
+	values := append(boundaryValues(),
+		new(big.Int).SetUint64(10000000000000000000-1),
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(19), nil),
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil),
+		new(big.Int).Neg(new(big.Int).Exp(big.NewInt(10), big.NewInt(76), nil)),
+	)
+	for _, x := range values {
+		if got, want := MustFromBig(x).String(), x.String(); got != want {
+			t.Errorf("Int.String() = %v, want %v", got, want)
+		}
+	}
+}
@@ -1,29 +1,95 @@
 package int256
 
-import (
-	"math/big"
-
-	"github.com/holiman/uint256"
-)
-
-// UnmarshalJSON implements json.Unmarshaler.
+// UnmarshalJSON implements json.Unmarshaler. It accepts a bare JSON number,
+// a quoted decimal string such as "-123" and a quoted hexadecimal string with
+// an optional sign such as "-0x7f". The JSON literal null leaves z
+// unchanged, as with big.Int.
+//
+// Malformed input yields ErrSyntax and values outside the int256 range yield
+// ErrOverflow; z is left unchanged on error.
 func (z *Int) UnmarshalJSON(input []byte) error {
-	b := new(big.Int)
-	if err := b.UnmarshalJSON(input); err != nil {
-		return err
+	s := string(input)
+	if s == "null" {
+		return nil
 	}
-	if b.Cmp(zero) < 0 {
-		b.Neg(b)
-		z.neg = true
-	} else {
-		z.neg = false
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return z.unmarshalString(s[1 : len(s)-1])
 	}
-	z.abs = new(uint256.Int)
-	z.abs.SetFromBig(b)
-	return nil
+	// A JSON number has no '+' sign and no hexadecimal form.
+	neg := len(s) > 0 && s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+	_, err := z.scan(s, neg, 10)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler. It encodes z as a bare JSON number.
+// Use the String or Hex wrapper types for clients, such as JavaScript, that
+// decode JSON numbers into float64 and would lose precision.
+//
+// MarshalJSON has a value receiver, like MarshalText, so that an Int is
+// encoded the same way whether or not encoding/json can take its address.
+func (z Int) MarshalJSON() ([]byte, error) {
+	return z.Append(nil, 10), nil
+}
+
+// String is an Int that is encoded in JSON as a quoted decimal string, such
+// as "-123". Convert between the two with (*String)(x) and (*Int)(s).
+// It decodes every form accepted by (*Int).UnmarshalJSON.
+type String Int
+
+// MarshalJSON implements json.Marshaler. Like (Int).MarshalJSON, it has a
+// value receiver.
+func (s String) MarshalJSON() ([]byte, error) {
+	buf := append(make([]byte, 0, 81), '"')
+	buf = (*Int)(&s).Append(buf, 10)
+	return append(buf, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *String) UnmarshalJSON(input []byte) error {
+	return (*Int)(s).UnmarshalJSON(input)
 }
 
-// MarshalJSON implements json.Marshaler.
-func (z *Int) MarshalJSON() ([]byte, error) {
-	return z.ToBig().MarshalJSON()
+// String returns the decimal representation of s. Like MarshalJSON, it has
+// a value receiver, so that fmt prints String values and fields by value.
+func (s String) String() string {
+	return (*Int)(&s).String()
+}
+
+// Hex is an Int that is encoded in JSON as a quoted hexadecimal string with
+// a "0x" prefix and, for negative values, a leading minus sign, such as
+// "-0x7b". Convert between the two with (*Hex)(x) and (*Int)(h).
+// It decodes every form accepted by (*Int).UnmarshalJSON.
+type Hex Int
+
+// MarshalJSON implements json.Marshaler. Like (Int).MarshalJSON, it has a
+// value receiver.
+func (h Hex) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + (*Int)(&h).Hex() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *Hex) UnmarshalJSON(input []byte) error {
+	return (*Int)(h).UnmarshalJSON(input)
+}
+
+// String returns the hexadecimal representation of h, as (*Int).Hex does.
+// Like MarshalJSON, it has a value receiver.
+func (h Hex) String() string {
+	return (*Int)(&h).Hex()
+}
+
+// unmarshalString sets z to the value of s, a decimal number or a
+// hexadecimal number with a "0x" or "0X" prefix, either with an optional
+// sign. z is left unchanged on error.
+func (z *Int) unmarshalString(s string) error {
+	neg, s := cutSign(s)
+	base := 10
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s, base = s[2:], 16
+	}
+	_, err := z.scan(s, neg, base)
+	return err
 }
@@ -1,6 +1,8 @@
 package int256
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -81,6 +83,162 @@ func TestInt_UnmarshalJSON(t *testing.T) {
 			wantErr: true,
 			want:    nil,
 		},
+		{
+			name: "Should perform correctly when data is quoted decimal",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`"-123"`),
+			},
+			wantErr: false,
+			want: &Int{
+				abs: uint256.NewInt(123),
+				neg: true,
+			},
+		},
+		{
+			name: "Should perform correctly when data is quoted hex",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`"0x7f"`),
+			},
+			wantErr: false,
+			want: &Int{
+				abs: uint256.NewInt(127),
+				neg: false,
+			},
+		},
+		{
+			name: "Should perform correctly when data is quoted negative hex",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`"-0X7F"`),
+			},
+			wantErr: false,
+			want: &Int{
+				abs: uint256.NewInt(127),
+				neg: true,
+			},
+		},
+		{
+			name: "Should perform correctly when data is min int256",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`-57896044618658097711785492504343953926634992332820282019728792003956564819968`),
+			},
+			wantErr: false,
+			want: &Int{
+				abs: new(uint256.Int).Set(minInt256Abs),
+				neg: true,
+			},
+		},
+		{
+			name: "Should err when data overflows int256",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`57896044618658097711785492504343953926634992332820282019728792003956564819968`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data overflows 256 bits",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`"0x10000000000000000000000000000000000000000000000000000000000000001"`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data is bare hex",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`0x7f`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data is a fraction",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`1.5`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data is an exponent",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`1e3`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data has plus sign",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`+1`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data is empty string",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`""`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
+		{
+			name: "Should err when data is a boolean",
+			fields: fields{
+				abs: new(uint256.Int),
+				neg: false,
+			},
+			args: args{
+				input: []byte(`true`),
+			},
+			wantErr: true,
+			want:    nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,6 +259,37 @@ func TestInt_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestInt_UnmarshalJSONStruct(t *testing.T) {
+	type pool struct {
+		Price *Int `json:"price"`
+		Tick  Int  `json:"tick"`
+	}
+
+	var p pool
+	if err := json.Unmarshal([]byte(`{"price":"-0x2a","tick":-7}`), &p); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if p.Price.Int64() != -42 || p.Tick.Int64() != -7 {
+		t.Errorf("json.Unmarshal() = %v, %v, want -42, -7", p.Price, &p.Tick)
+	}
+
+	// null leaves the value untouched.
+	if err := json.Unmarshal([]byte(`{"tick":null}`), &p); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if p.Tick.Int64() != -7 {
+		t.Errorf("json.Unmarshal(null) = %v, want -7", &p.Tick)
+	}
+
+	err := json.Unmarshal([]byte(`{"tick":"0x8000000000000000000000000000000000000000000000000000000000000000"}`), &p)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("json.Unmarshal() error = %v, want %v", err, ErrOverflow)
+	}
+	if p.Tick.Int64() != -7 {
+		t.Errorf("json.Unmarshal() = %v, want value unchanged on error", &p.Tick)
+	}
+}
+
 func marshalJSON(bigInt *big.Int) []byte {
 	bytes, _ := bigInt.MarshalJSON()
 	fmt.Println(string(bytes))
@@ -143,3 +332,102 @@ func TestInt_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONOutputModes(t *testing.T) {
+	type pool struct {
+		Number *Int    `json:"number"`
+		Dec    *String `json:"dec"`
+		Hex    *Hex    `json:"hex"`
+	}
+	tests := []struct {
+		name string
+		x    *Int
+		want string
+	}{
+		{
+			name: "Should encode positive value in every mode",
+			x:    NewInt(123),
+			want: `{"number":123,"dec":"123","hex":"0x7b"}`,
+		},
+		{
+			name: "Should encode negative value in every mode",
+			x:    NewInt(-123),
+			want: `{"number":-123,"dec":"-123","hex":"-0x7b"}`,
+		},
+		{
+			name: "Should encode zero in every mode",
+			x:    New(),
+			want: `{"number":0,"dec":"0","hex":"0x0"}`,
+		},
+		{
+			name: "Should encode min int256 in every mode",
+			x:    MinInt256(),
+			want: `{"number":-57896044618658097711785492504343953926634992332820282019728792003956564819968,` +
+				`"dec":"-57896044618658097711785492504343953926634992332820282019728792003956564819968",` +
+				`"hex":"-0x8000000000000000000000000000000000000000000000000000000000000000"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := pool{Number: tt.x, Dec: (*String)(tt.x), Hex: (*Hex)(tt.x)}
+			got, err := json.Marshal(in)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, tt.want, string(got))
+
+			var out pool
+			assert.Equal(t, nil, json.Unmarshal(got, &out))
+			assert.Equal(t, 0, out.Number.Cmp(tt.x))
+			assert.Equal(t, 0, (*Int)(out.Dec).Cmp(tt.x))
+			assert.Equal(t, 0, (*Int)(out.Hex).Cmp(tt.x))
+		})
+	}
+}
+
+func TestJSONOutputModes_String(t *testing.T) {
+	x := NewInt(-42)
+	assert.Equal(t, "-42", (*String)(x).String())
+	assert.Equal(t, "-0x2a", (*Hex)(x).String())
+	assert.Equal(t, "-0x2a", fmt.Sprint((*Hex)(x)))
+
+	// Values and fields held by value print the number, not the struct.
+	assert.Equal(t, "-42 -0x2a", fmt.Sprint(String(*x), " ", Hex(*x)))
+	type pool struct {
+		Dec String
+		Hex Hex
+	}
+	assert.Equal(t, "{-42 -0x2a}", fmt.Sprint(pool{Dec: String(*x), Hex: Hex(*x)}))
+}
+
+func TestInt_MarshalJSONByValue(t *testing.T) {
+	type pool struct {
+		Price Int  `json:"price"`
+		Tick  *Int `json:"tick"`
+	}
+	p := pool{Price: *NewInt(-5), Tick: NewInt(-7)}
+
+	byValue, err := json.Marshal(p)
+	assert.Equal(t, nil, err)
+	byPointer, err := json.Marshal(&p)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"price":-5,"tick":-7}`, string(byValue))
+	assert.Equal(t, string(byValue), string(byPointer))
+
+	data, err := json.Marshal([]Int{*NewInt(1), *NewInt(-1)})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `[1,-1]`, string(data))
+}
+
+func TestJSONOutputModesByValue(t *testing.T) {
+	type pool struct {
+		Dec String `json:"dec"`
+		Hex Hex    `json:"hex"`
+	}
+	p := pool{Dec: String(*NewInt(-123)), Hex: Hex(*NewInt(-123))}
+
+	byValue, err := json.Marshal(p)
+	assert.Equal(t, nil, err)
+	byPointer, err := json.Marshal(&p)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"dec":"-123","hex":"-0x7b"}`, string(byValue))
+	assert.Equal(t, string(byValue), string(byPointer))
+}
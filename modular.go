@@ -0,0 +1,139 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// expMod sets z = x**y mod m for m != 0 and returns z.
+func expMod(z, x, y, m *uint256.Int) *uint256.Int {
+	var res, base uint256.Int
+	res.Mod(one, m)
+	base.Mod(x, m)
+	for i, n := 0, y.BitLen(); i < n; i++ {
+		if y[i/64]>>(i%64)&1 == 1 {
+			res.MulMod(&res, &base, m)
+		}
+		base.MulMod(&base, &base, m)
+	}
+	return z.Set(&res)
+}
+
+// modInverse sets z to the multiplicative inverse of g modulo n for n != 0,
+// and reports whether the inverse exists. If it does not, z is unchanged.
+func modInverse(z, g, n *uint256.Int) bool {
+	// Extended Euclidean algorithm, keeping only the Bézout coefficients
+	// of g and reducing them modulo n so that they stay within 256 bits:
+	// r0 ≡ t0*g and r1 ≡ t1*g (mod n).
+	var r0, r1, t0, t1, q, r, p uint256.Int
+	r0.Set(n)
+	r1.Mod(g, n)
+	t1.SetOne()
+	for !r1.IsZero() {
+		q.DivMod(&r0, &r1, &r)
+		r0.Set(&r1)
+		r1.Set(&r)
+
+		// t0, t1 = t1, t0 - q*t1 (mod n)
+		p.MulMod(&q, &t1, n)
+		if t0.Lt(&p) {
+			p.Sub(n, &p)
+			p.Add(&p, &t0)
+		} else {
+			p.Sub(&t0, &p)
+		}
+		t0.Set(&t1)
+		t1.Set(&p)
+	}
+	if !r0.Eq(one) {
+		return false
+	}
+	z.Mod(&t0, n)
+	return true
+}
+
+// AddMod sets z to the sum x+y modulo |m| for m != 0 and returns z.
+// The sum is computed without intermediate overflow, and z is in [0, |m|),
+// as with Mod. If m == 0, a division-by-zero run-time panic occurs.
+func (z *Int) AddMod(x, y, m *Int) *Int {
+	m = operand(m)
+	var q, a, b uint256.Int
+	euclidDivMod(&q, &a, x, m)
+	euclidDivMod(&q, &b, y, m)
+	return z.setAbs(a.AddMod(&a, &b, m.abs), false)
+}
+
+// MulMod sets z to the product x*y modulo |m| for m != 0 and returns z.
+// The product is computed with a 512-bit intermediate, and z is in [0, |m|),
+// as with Mod. If m == 0, a division-by-zero run-time panic occurs.
+func (z *Int) MulMod(x, y, m *Int) *Int {
+	m = operand(m)
+	var q, a, b uint256.Int
+	euclidDivMod(&q, &a, x, m)
+	euclidDivMod(&q, &b, y, m)
+	return z.setAbs(a.MulMod(&a, &b, m.abs), false)
+}
+
+// ModInverse sets z to the multiplicative inverse of g in the ring ℤ/nℤ
+// and returns z. If g and n are not relatively prime, g has no multiplicative
+// inverse in the ring ℤ/nℤ.  In this case, z is unchanged and the return value
+// is nil. If n == 0, a division-by-zero run-time panic occurs.
+func (z *Int) ModInverse(g, n *Int) *Int {
+	n = operand(n)
+	var q, r uint256.Int
+	euclidDivMod(&q, &r, g, n)
+	if !modInverse(&r, &r, n.abs) {
+		return nil
+	}
+	return z.setAbs(&r, false)
+}
+
+// GCD sets z to the greatest common divisor of a and b and returns z.
+// If x or y are not nil, GCD sets their value such that z = a*x + b*y.
+//
+// a and b may be positive, zero or negative. Regardless of the signs of a
+// and b, z is always >= 0, except that a GCD of 2^255 (when a and b are each
+// MinInt256 or 0) wraps around to MinInt256, like Abs.
+//
+// If a == b == 0, GCD sets z = x = y = 0.
+//
+// If a == 0 and b != 0, GCD sets z = |b|, x = 0, y = sign(b) * 1.
+//
+// If a != 0 and b == 0, GCD sets z = |a|, x = sign(a) * 1, y = 0.
+func (z *Int) GCD(x, y, a, b *Int) *Int {
+	a, b = operand(a), operand(b)
+	aNeg, bNeg := a.neg, b.neg
+	// Extended Euclidean algorithm on the magnitudes. The Bézout
+	// coefficients alternate in sign (s is positive on even steps, t on
+	// odd ones), so only their magnitudes are tracked.
+	var r0, r1, s0, s1, t0, t1, q, r, p uint256.Int
+	r0.Set(a.abs)
+	r1.Set(b.abs)
+	s0.SetOne()
+	t1.SetOne()
+	even := true
+	for !r1.IsZero() {
+		q.DivMod(&r0, &r1, &r)
+		r0.Set(&r1)
+		r1.Set(&r)
+
+		p.Mul(&q, &s1)
+		p.Add(&p, &s0)
+		s0.Set(&s1)
+		s1.Set(&p)
+
+		p.Mul(&q, &t1)
+		p.Add(&p, &t0)
+		t0.Set(&t1)
+		t1.Set(&p)
+
+		even = !even
+	}
+	if r0.IsZero() {
+		s0.Clear()
+	}
+	if x != nil {
+		x.setAbs(&s0, even == aNeg)
+	}
+	if y != nil {
+		y.setAbs(&t0, even != bNeg)
+	}
+	return z.setAbs(&r0, false).wrap()
+}
@@ -0,0 +1,78 @@
+package int256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInt_ModularMatchesBig(t *testing.T) {
+	for _, x := range boundaryValues() {
+		for _, y := range boundaryValues() {
+			for _, m := range boundaryValues() {
+				if m.Sign() == 0 {
+					continue
+				}
+				want := new(big.Int).Add(x, y)
+				want.Mod(want, m)
+				if got := new(Int).AddMod(MustFromBig(x), MustFromBig(y), MustFromBig(m)); got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.AddMod(%v, %v, %v) = %v, want %v", x, y, m, got, want)
+				}
+				want = new(big.Int).Mul(x, y)
+				want.Mod(want, m)
+				if got := new(Int).MulMod(MustFromBig(x), MustFromBig(y), MustFromBig(m)); got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.MulMod(%v, %v, %v) = %v, want %v", x, y, m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestInt_ModInverse(t *testing.T) {
+	for _, g := range boundaryValues() {
+		for _, n := range boundaryValues() {
+			if n.Sign() == 0 {
+				continue
+			}
+			want := new(big.Int).ModInverse(g, n)
+			z := NewInt(42)
+			got := z.ModInverse(MustFromBig(g), MustFromBig(n))
+			if want == nil {
+				if got != nil || z.Cmp(NewInt(42)) != 0 {
+					t.Errorf("Int.ModInverse(%v, %v) = %v, want nil and z unchanged", g, n, got)
+				}
+				continue
+			}
+			if got == nil || got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.ModInverse(%v, %v) = %v, want %v", g, n, got, want)
+			}
+		}
+	}
+}
+
+func TestInt_GCD(t *testing.T) {
+	values := append(boundaryValues(), big.NewInt(12), big.NewInt(-18), big.NewInt(240), big.NewInt(46))
+	for _, a := range values {
+		for _, b := range values {
+			want := new(big.Int).GCD(nil, nil, a, b)
+			if want.Cmp(bigMaxInt256) > 0 {
+				// A GCD of 2^255 does not fit in int256.
+				continue
+			}
+			x, y := new(Int), new(Int)
+			got := new(Int).GCD(x, y, MustFromBig(a), MustFromBig(b))
+			if got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.GCD(%v, %v) = %v, want %v", a, b, got, want)
+			}
+			// The Bézout coefficients are not unique, so check the identity
+			// rather than comparing with math/big.
+			sum := new(big.Int).Mul(a, x.ToBig())
+			sum.Add(sum, new(big.Int).Mul(b, y.ToBig()))
+			if sum.Cmp(want) != 0 {
+				t.Errorf("Int.GCD(%v, %v): a*x + b*y = %v*%v + %v*%v != %v", a, b, a, x, b, y, want)
+			}
+			if got := new(Int).GCD(nil, nil, MustFromBig(a), MustFromBig(b)); got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.GCD(nil, nil, %v, %v) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// Rounding selects how MulDiv rounds a quotient that is not exact.
+type Rounding int
+
+const (
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor Rounding = iota
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+	// RoundTowardZero truncates, like Quo.
+	RoundTowardZero
+	// RoundHalfEven rounds to the nearest integer, and ties to the even one.
+	RoundHalfEven
+)
+
+// MulDiv sets z to x*y/d rounded as selected by rounding, and returns z and
+// whether overflow occurred. The product is kept at full 512-bit precision,
+// so only a final result outside int256 overflows; z then holds that result
+// wrapped around modulo 2^256.
+// If d == 0, a division-by-zero run-time panic occurs. MulDiv also panics if
+// rounding is not one of the Round constants, whether or not the quotient is
+// exact.
+func (z *Int) MulDiv(x, y, d *Int, rounding Rounding) (*Int, bool) {
+	if rounding < RoundFloor || rounding > RoundHalfEven {
+		panic("int256: invalid rounding mode")
+	}
+	x, y, d = operand(x), operand(y), operand(d)
+	if d.abs.IsZero() {
+		panic("division by zero")
+	}
+	neg := x.neg != y.neg != d.neg
+
+	var q, r uint256.Int
+	_, overflow := q.MulDivOverflow(x.abs, y.abs, d.abs)
+	r.MulMod(x.abs, y.abs, d.abs)
+
+	if !r.IsZero() {
+		var up bool
+		switch rounding {
+		case RoundFloor:
+			up = neg
+		case RoundCeil:
+			up = !neg
+		case RoundTowardZero:
+			up = false
+		case RoundHalfEven:
+			// r < |d| <= 2^255, so doubling it cannot overflow.
+			r.Lsh(&r, 1)
+			c := r.Cmp(d.abs)
+			up = c > 0 || c == 0 && q[0]&1 == 1
+		}
+		if up {
+			q.AddUint64(&q, 1)
+			overflow = overflow || q.IsZero()
+		}
+	}
+	overflow = overflow || !inRange(&q, neg)
+	return z.setAbs(&q, neg).wrap(), overflow
+}
@@ -0,0 +1,158 @@
+package int256
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// roundBig returns n/d rounded as selected by rounding.
+func roundBig(n, d *big.Int, rounding Rounding) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	neg := n.Sign() != d.Sign()
+	var up bool
+	switch rounding {
+	case RoundFloor:
+		up = neg
+	case RoundCeil:
+		up = !neg
+	case RoundHalfEven:
+		c := new(big.Int).Lsh(new(big.Int).Abs(r), 1).CmpAbs(d)
+		up = c > 0 || c == 0 && q.Bit(0) == 1
+	}
+	if !up {
+		return q
+	}
+	if neg {
+		return q.Sub(q, big.NewInt(1))
+	}
+	return q.Add(q, big.NewInt(1))
+}
+
+func TestInt_MulDivMatchesBig(t *testing.T) {
+	divisors := append(boundaryValues(), big.NewInt(4), big.NewInt(-6), big.NewInt(10))
+	for _, rounding := range []Rounding{RoundFloor, RoundCeil, RoundTowardZero, RoundHalfEven} {
+		for _, x := range boundaryValues() {
+			for _, y := range boundaryValues() {
+				for _, d := range divisors {
+					if d.Sign() == 0 {
+						continue
+					}
+					want, wantOverflow := wrapBig(roundBig(new(big.Int).Mul(x, y), d, rounding))
+					got, overflow := new(Int).MulDiv(MustFromBig(x), MustFromBig(y), MustFromBig(d), rounding)
+					if got.ToBig().Cmp(want) != 0 || overflow != wantOverflow {
+						t.Errorf("Int.MulDiv(%v, %v, %v, %v) = %v, %v, want %v, %v", x, y, d, rounding, got, overflow, want, wantOverflow)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestInt_MulDiv(t *testing.T) {
+	type args struct {
+		x        *Int
+		y        *Int
+		d        *Int
+		rounding Rounding
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         *Int
+		wantOverflow bool
+	}{
+		{
+			name: "Should keep full precision when the product exceeds 256 bits",
+			args: args{
+				x:        MaxInt256(),
+				y:        NewInt(-4),
+				d:        NewInt(8),
+				rounding: RoundTowardZero,
+			},
+			want: new(Int).Sub(NewInt(1), new(Int).Lsh(NewInt(1), 254)),
+		},
+		{
+			name: "Should report overflow when the result does not fit in int256",
+			args: args{
+				x:        MaxInt256(),
+				y:        NewInt(-6),
+				d:        NewInt(3),
+				rounding: RoundTowardZero,
+			},
+			want:         NewInt(2),
+			wantOverflow: true,
+		},
+		{
+			name: "Should round toward negative infinity when using floor",
+			args: args{
+				x:        NewInt(-7),
+				y:        NewInt(3),
+				d:        NewInt(2),
+				rounding: RoundFloor,
+			},
+			want: NewInt(-11),
+		},
+		{
+			name: "Should round toward positive infinity when using ceil",
+			args: args{
+				x:        NewInt(-7),
+				y:        NewInt(3),
+				d:        NewInt(2),
+				rounding: RoundCeil,
+			},
+			want: NewInt(-10),
+		},
+		{
+			name: "Should round ties to even when using half even",
+			args: args{
+				x:        NewInt(-7),
+				y:        NewInt(3),
+				d:        NewInt(2),
+				rounding: RoundHalfEven,
+			},
+			want: NewInt(-10),
+		},
+		{
+			name: "Should round to nearest when using half even",
+			args: args{
+				x:        NewInt(5),
+				y:        NewInt(5),
+				d:        NewInt(3),
+				rounding: RoundHalfEven,
+			},
+			want: NewInt(8),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := new(Int).MulDiv(tt.args.x, tt.args.y, tt.args.d, tt.args.rounding)
+			if !reflect.DeepEqual(got, tt.want) || overflow != tt.wantOverflow {
+				t.Errorf("Int.MulDiv() = %v, %v, want %v, %v", got, overflow, tt.want, tt.wantOverflow)
+			}
+		})
+	}
+}
+
+func TestInt_MulDivInvalidRounding(t *testing.T) {
+	tests := []struct {
+		name string
+		x    int64
+	}{
+		{name: "Should panic when the quotient is exact", x: 6},
+		{name: "Should panic when the quotient is inexact", x: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Int.MulDiv() did not panic on an invalid rounding mode")
+				}
+			}()
+			new(Int).MulDiv(NewInt(tt.x), NewInt(2), NewInt(3), Rounding(42))
+		})
+	}
+}
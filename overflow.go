@@ -0,0 +1,166 @@
+package int256
+
+import "github.com/holiman/uint256"
+
+// AddOverflow sets z to the sum x+y, and returns z and whether overflow
+// occurred. On overflow z holds the wrapped-around sum, like Add.
+func (z *Int) AddOverflow(x, y *Int) (*Int, bool) {
+	var w uint256.Int
+	overflow := addWord(&w, x, y)
+	return z.setWord(&w), overflow
+}
+
+// SubOverflow sets z to the difference x-y, and returns z and whether
+// overflow occurred. On overflow z holds the wrapped-around difference, like
+// Sub.
+func (z *Int) SubOverflow(x, y *Int) (*Int, bool) {
+	var w uint256.Int
+	overflow := subWord(&w, x, y)
+	return z.setWord(&w), overflow
+}
+
+// MulOverflow sets z to the product x*y, and returns z and whether overflow
+// occurred. On overflow z holds the wrapped-around product, like Mul.
+func (z *Int) MulOverflow(x, y *Int) (*Int, bool) {
+	var w uint256.Int
+	overflow := mulWord(&w, x, y)
+	return z.setWord(&w), overflow
+}
+
+// addWord sets w to the two's-complement encoding of the wrapped-around sum
+// x+y and reports whether the sum overflowed.
+func addWord(w *uint256.Int, x, y *Int) bool {
+	var b uint256.Int
+	x.toWord(w)
+	y.toWord(&b)
+	xNeg, yNeg := w.Sign() < 0, b.Sign() < 0
+	w.Add(w, &b)
+	// The sum overflows exactly when x and y have the same sign and the
+	// wrapped-around sum has the other one.
+	return xNeg == yNeg && (w.Sign() < 0) != xNeg
+}
+
+// subWord sets w to the two's-complement encoding of the wrapped-around
+// difference x-y and reports whether the difference overflowed.
+func subWord(w *uint256.Int, x, y *Int) bool {
+	var b uint256.Int
+	x.toWord(w)
+	y.toWord(&b)
+	xNeg, yNeg := w.Sign() < 0, b.Sign() < 0
+	w.Sub(w, &b)
+	// The difference overflows exactly when x and y have different signs
+	// and the wrapped-around difference does not have the sign of x.
+	return xNeg != yNeg && (w.Sign() < 0) != xNeg
+}
+
+// mulWord sets w to the two's-complement encoding of the wrapped-around
+// product x*y and reports whether the product overflowed.
+func mulWord(w *uint256.Int, x, y *Int) bool {
+	x, y = operand(x), operand(y)
+	var b, p uint256.Int
+	_, overflow := p.MulOverflow(x.abs, y.abs)
+	overflow = overflow || !inRange(&p, x.neg != y.neg)
+	x.toWord(w)
+	y.toWord(&b)
+	w.Mul(w, &b)
+	return overflow
+}
+
+// QuoOverflow sets z to the quotient x/y for y != 0, and returns z and
+// whether overflow occurred. The only overflowing case is MinInt256 / -1,
+// for which z is set to MinInt256, like Quo.
+func (z *Int) QuoOverflow(x, y *Int) (*Int, bool) {
+	overflow := quoOverflows(x, y)
+	return z.Quo(x, y), overflow
+}
+
+// DivOverflow sets z to the Euclidean quotient x div y for y != 0, and
+// returns z and whether overflow occurred. The only overflowing case is
+// MinInt256 div -1, for which z is set to MinInt256, like Div.
+func (z *Int) DivOverflow(x, y *Int) (*Int, bool) {
+	overflow := quoOverflows(x, y)
+	return z.Div(x, y), overflow
+}
+
+// quoOverflows reports whether x/y overflows, which happens only for
+// MinInt256 / -1. This holds for both truncated and Euclidean division.
+func quoOverflows(x, y *Int) bool {
+	y = operand(y)
+	return x.isMinInt256() && y.neg && y.abs.Eq(one)
+}
+
+// NegOverflow sets z to -x, and returns z and whether overflow occurred.
+// The only overflowing case is -MinInt256, for which z is set to MinInt256,
+// like Neg.
+func (z *Int) NegOverflow(x *Int) (*Int, bool) {
+	overflow := x.isMinInt256()
+	return z.Neg(x), overflow
+}
+
+// AbsOverflow sets z to |x|, and returns z and whether overflow occurred.
+// The only overflowing case is |MinInt256|, for which z is set to
+// MinInt256, like Abs.
+func (z *Int) AbsOverflow(x *Int) (*Int, bool) {
+	overflow := x.isMinInt256()
+	return z.Abs(x), overflow
+}
+
+// ExpOverflow sets z to x**y for y >= 0, and returns z and whether overflow
+// occurred. On overflow z holds the wrapped-around power, like Exp with a nil
+// modulus. If y < 0, z is set to 1 and no overflow is reported.
+func (z *Int) ExpOverflow(x, y *Int) (*Int, bool) {
+	if y.Sign() < 0 {
+		return z.SetUint64(1), false
+	}
+	var w uint256.Int
+	overflow := expWord(&w, x, y)
+	return z.setWord(&w), overflow
+}
+
+// expWord sets w to the two's-complement encoding of the wrapped-around
+// power x**y for y >= 0 and reports whether the power overflowed.
+func expWord(w *uint256.Int, x, y *Int) bool {
+	x, y = operand(x), operand(y)
+	// Square-and-multiply on the magnitude, tracking whether any factor
+	// that was actually used exceeded 256 bits.
+	var (
+		res          = uint256.Int{1}
+		multiplier   = *x.abs
+		multOverflow bool
+		overflow     bool
+	)
+	for i, n := 0, y.abs.BitLen(); i < n; i++ {
+		if y.abs[i/64]>>(i%64)&1 == 1 {
+			_, of := res.MulOverflow(&res, &multiplier)
+			overflow = overflow || of || multOverflow
+		}
+		if i+1 < n {
+			_, of := multiplier.MulOverflow(&multiplier, &multiplier)
+			multOverflow = multOverflow || of
+		}
+	}
+	neg := x.neg && y.abs[0]&1 == 1
+	overflow = overflow || !inRange(&res, neg)
+
+	x.toWord(w)
+	w.Exp(w, y.abs)
+	return overflow
+}
+
+// LshOverflow sets z = x << n, and returns z and whether overflow occurred,
+// that is, whether any significant bit (including the sign) was lost. On
+// overflow z holds the wrapped-around result, like Lsh.
+func (z *Int) LshOverflow(x *Int, n uint) (*Int, bool) {
+	var w, r, back uint256.Int
+	x.toWord(&w)
+	r.Lsh(&w, n)
+	// Shifting the result back must reproduce x if nothing was lost.
+	overflow := !back.SRsh(&r, n).Eq(&w)
+	return z.setWord(&r), overflow
+}
+
+// isMinInt256 reports whether z == MinInt256.
+func (z *Int) isMinInt256() bool {
+	z = operand(z)
+	return z.neg && z.abs.Eq(minInt256Abs)
+}
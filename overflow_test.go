@@ -0,0 +1,330 @@
+package int256
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var (
+	bigMaxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	bigMinInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+)
+
+// boundaryValues returns values around zero and around both ends of the
+// int256 range.
+func boundaryValues() []*big.Int {
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		big.NewInt(2),
+		big.NewInt(-2),
+		big.NewInt(3),
+		big.NewInt(-7),
+		new(big.Int).Lsh(big.NewInt(1), 128),
+		new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 128)),
+		new(big.Int).Lsh(big.NewInt(1), 254),
+		new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 254)),
+	}
+	for _, d := range []int64{0, 1, 2} {
+		values = append(values,
+			new(big.Int).Sub(bigMaxInt256, big.NewInt(d)),
+			new(big.Int).Add(bigMinInt256, big.NewInt(d)),
+		)
+	}
+	return values
+}
+
+// wrapBig reduces x into the int256 range, wrapping around in two's
+// complement, and reports whether x was outside the range.
+func wrapBig(x *big.Int) (*big.Int, bool) {
+	if x.Cmp(bigMinInt256) >= 0 && x.Cmp(bigMaxInt256) <= 0 {
+		return x, false
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), 256)
+	w := new(big.Int).Mod(x, mod)
+	if w.Cmp(bigMaxInt256) > 0 {
+		w.Sub(w, mod)
+	}
+	return w, true
+}
+
+func TestInt_OverflowMatchesBig(t *testing.T) {
+	ops := []struct {
+		name string
+		op   func(z, x, y *Int) (*Int, bool)
+		big  func(x, y *big.Int) *big.Int
+	}{
+		{"AddOverflow", (*Int).AddOverflow, func(x, y *big.Int) *big.Int { return new(big.Int).Add(x, y) }},
+		{"SubOverflow", (*Int).SubOverflow, func(x, y *big.Int) *big.Int { return new(big.Int).Sub(x, y) }},
+		{"MulOverflow", (*Int).MulOverflow, func(x, y *big.Int) *big.Int { return new(big.Int).Mul(x, y) }},
+	}
+	for _, op := range ops {
+		for _, x := range boundaryValues() {
+			for _, y := range boundaryValues() {
+				want, wantOverflow := wrapBig(op.big(x, y))
+				got, overflow := op.op(new(Int), MustFromBig(x), MustFromBig(y))
+				if got.ToBig().Cmp(want) != 0 || overflow != wantOverflow {
+					t.Errorf("Int.%s(%v, %v) = %v, %v, want %v, %v", op.name, x, y, got, overflow, want, wantOverflow)
+				}
+			}
+		}
+	}
+}
+
+func TestInt_QuoOverflow(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         *Int
+		wantOverflow bool
+	}{
+		{
+			name: "Should not overflow when performing two negative numbers",
+			args: args{
+				x: NewInt(-10),
+				y: NewInt(-3),
+			},
+			want:         NewInt(3),
+			wantOverflow: false,
+		},
+		{
+			name: "Should overflow when dividing min int256 by -1",
+			args: args{
+				x: MinInt256(),
+				y: NewInt(-1),
+			},
+			want:         MinInt256(),
+			wantOverflow: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := new(Int).QuoOverflow(tt.args.x, tt.args.y)
+			if !reflect.DeepEqual(got, tt.want) || overflow != tt.wantOverflow {
+				t.Errorf("Int.QuoOverflow() = %v, %v, want %v, %v", got, overflow, tt.want, tt.wantOverflow)
+			}
+		})
+	}
+}
+
+func TestInt_DivOverflow(t *testing.T) {
+	tests := []struct {
+		name         string
+		x, y         *Int
+		want         *Int
+		wantOverflow bool
+	}{
+		{
+			name:         "Should round toward negative infinity for a positive divisor",
+			x:            NewInt(-10),
+			y:            NewInt(3),
+			want:         NewInt(-4),
+			wantOverflow: false,
+		},
+		{
+			name:         "Should not overflow when dividing min int256 by 1",
+			x:            MinInt256(),
+			y:            NewInt(1),
+			want:         MinInt256(),
+			wantOverflow: false,
+		},
+		{
+			name:         "Should overflow when dividing min int256 by -1",
+			x:            MinInt256(),
+			y:            NewInt(-1),
+			want:         MinInt256(),
+			wantOverflow: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := new(Int).DivOverflow(tt.x, tt.y)
+			if !reflect.DeepEqual(got, tt.want) || overflow != tt.wantOverflow {
+				t.Errorf("Int.DivOverflow() = %v, %v, want %v, %v", got, overflow, tt.want, tt.wantOverflow)
+			}
+		})
+	}
+
+	// big.Int.Div is Euclidean too.
+	for _, x := range boundaryValues() {
+		for _, y := range boundaryValues() {
+			if y.Sign() == 0 {
+				continue
+			}
+			want, wantOverflow := wrapBig(new(big.Int).Div(x, y))
+			got, overflow := new(Int).DivOverflow(MustFromBig(x), MustFromBig(y))
+			if got.ToBig().Cmp(want) != 0 || overflow != wantOverflow {
+				t.Errorf("Int.DivOverflow(%v, %v) = %v, %v, want %v, %v", x, y, got, overflow, want, wantOverflow)
+			}
+		}
+	}
+}
+
+func TestInt_NegOverflow(t *testing.T) {
+	tests := []struct {
+		name         string
+		x            *Int
+		want         *Int
+		wantOverflow bool
+	}{
+		{
+			name:         "Should not overflow when negating max int256",
+			x:            MaxInt256(),
+			want:         new(Int).Add(MinInt256(), NewInt(1)),
+			wantOverflow: false,
+		},
+		{
+			name:         "Should overflow when negating min int256",
+			x:            MinInt256(),
+			want:         MinInt256(),
+			wantOverflow: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := new(Int).NegOverflow(tt.x)
+			if !reflect.DeepEqual(got, tt.want) || overflow != tt.wantOverflow {
+				t.Errorf("Int.NegOverflow() = %v, %v, want %v, %v", got, overflow, tt.want, tt.wantOverflow)
+			}
+			got, overflow = new(Int).AbsOverflow(tt.x)
+			if got.Sign() < 0 != tt.wantOverflow || overflow != tt.wantOverflow {
+				t.Errorf("Int.AbsOverflow() = %v, %v, want overflow %v", got, overflow, tt.wantOverflow)
+			}
+		})
+	}
+}
+
+func TestInt_ExpOverflow(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOverflow bool
+	}{
+		{
+			name: "Should not overflow when the power is min int256",
+			args: args{
+				x: NewInt(-2),
+				y: NewInt(255),
+			},
+			wantOverflow: false,
+		},
+		{
+			name: "Should overflow when the positive power is 2^255",
+			args: args{
+				x: NewInt(2),
+				y: NewInt(255),
+			},
+			wantOverflow: true,
+		},
+		{
+			name: "Should overflow when the power exceeds 256 bits",
+			args: args{
+				x: NewInt(-3),
+				y: NewInt(200),
+			},
+			wantOverflow: true,
+		},
+		{
+			name: "Should not overflow when the last squaring exceeds 256 bits",
+			args: args{
+				x: NewInt(1 << 40),
+				y: NewInt(4),
+			},
+			wantOverflow: false,
+		},
+		{
+			name: "Should not overflow when the exponent is zero",
+			args: args{
+				x: MinInt256(),
+				y: NewInt(0),
+			},
+			wantOverflow: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, wantOverflow := wrapBig(new(big.Int).Exp(tt.args.x.ToBig(), tt.args.y.ToBig(), nil))
+			got, overflow := new(Int).ExpOverflow(tt.args.x, tt.args.y)
+			if got.ToBig().Cmp(want) != 0 || overflow != tt.wantOverflow || overflow != wantOverflow {
+				t.Errorf("Int.ExpOverflow() = %v, %v, want %v, %v", got, overflow, want, tt.wantOverflow)
+			}
+		})
+	}
+}
+
+func TestInt_LshOverflow(t *testing.T) {
+	type args struct {
+		x *Int
+		n uint
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         *Int
+		wantOverflow bool
+	}{
+		{
+			name: "Should not overflow when shifting a negative number into the sign bit",
+			args: args{
+				x: NewInt(-1),
+				n: 255,
+			},
+			want:         MinInt256(),
+			wantOverflow: false,
+		},
+		{
+			name: "Should overflow when shifting a positive number into the sign bit",
+			args: args{
+				x: NewInt(1),
+				n: 255,
+			},
+			want:         MinInt256(),
+			wantOverflow: true,
+		},
+		{
+			name: "Should overflow when shifting bits beyond bit 255",
+			args: args{
+				x: NewInt(3),
+				n: 255,
+			},
+			want:         MinInt256(),
+			wantOverflow: true,
+		},
+		{
+			name: "Should not overflow when shifting zero by 256",
+			args: args{
+				x: NewInt(0),
+				n: 256,
+			},
+			want:         NewInt(0),
+			wantOverflow: false,
+		},
+		{
+			name: "Should overflow when shifting a negative number by 256",
+			args: args{
+				x: NewInt(-1),
+				n: 256,
+			},
+			want:         NewInt(0),
+			wantOverflow: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, overflow := new(Int).LshOverflow(tt.args.x, tt.args.n)
+			if !reflect.DeepEqual(got, tt.want) || overflow != tt.wantOverflow {
+				t.Errorf("Int.LshOverflow() = %v, %v, want %v, %v", got, overflow, tt.want, tt.wantOverflow)
+			}
+		})
+	}
+}
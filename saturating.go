@@ -0,0 +1,50 @@
+package int256
+
+// SatAdd sets z to the sum x+y and returns z. If the sum does not fit in
+// int256, z is clamped to MaxInt256 or MinInt256 instead of wrapping around.
+func (z *Int) SatAdd(x, y *Int) *Int {
+	// On overflow x and y share a sign, which is the sign of the exact sum.
+	neg := x.Sign() < 0
+	if _, overflow := z.AddOverflow(x, y); overflow {
+		return z.saturate(neg)
+	}
+	return z
+}
+
+// SatSub sets z to the difference x-y and returns z. If the difference does
+// not fit in int256, z is clamped to MaxInt256 or MinInt256 instead of
+// wrapping around.
+func (z *Int) SatSub(x, y *Int) *Int {
+	// On overflow x and y have different signs, and the exact difference
+	// has the sign of x.
+	neg := x.Sign() < 0
+	if _, overflow := z.SubOverflow(x, y); overflow {
+		return z.saturate(neg)
+	}
+	return z
+}
+
+// SatMul sets z to the product x*y and returns z. If the product does not
+// fit in int256, z is clamped to MaxInt256 or MinInt256 instead of wrapping
+// around.
+func (z *Int) SatMul(x, y *Int) *Int {
+	neg := x.Sign()*y.Sign() < 0
+	if _, overflow := z.MulOverflow(x, y); overflow {
+		return z.saturate(neg)
+	}
+	return z
+}
+
+// saturate sets z to MinInt256 if neg, or to MaxInt256 otherwise, and
+// returns z.
+func (z *Int) saturate(neg bool) *Int {
+	z.initiateAbs()
+
+	z.neg = neg
+	if neg {
+		z.abs.Set(minInt256Abs)
+	} else {
+		z.abs.Sub(minInt256Abs, one)
+	}
+	return z
+}
@@ -0,0 +1,83 @@
+package int256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// clampBig clamps x to the int256 range.
+func clampBig(x *big.Int) *big.Int {
+	if x.Cmp(bigMaxInt256) > 0 {
+		return bigMaxInt256
+	}
+	if x.Cmp(bigMinInt256) < 0 {
+		return bigMinInt256
+	}
+	return x
+}
+
+func TestInt_SaturatingMatchesBig(t *testing.T) {
+	ops := []struct {
+		name string
+		op   func(z, x, y *Int) *Int
+		big  func(x, y *big.Int) *big.Int
+	}{
+		{"SatAdd", (*Int).SatAdd, func(x, y *big.Int) *big.Int { return new(big.Int).Add(x, y) }},
+		{"SatSub", (*Int).SatSub, func(x, y *big.Int) *big.Int { return new(big.Int).Sub(x, y) }},
+		{"SatMul", (*Int).SatMul, func(x, y *big.Int) *big.Int { return new(big.Int).Mul(x, y) }},
+	}
+	for _, op := range ops {
+		for _, x := range boundaryValues() {
+			for _, y := range boundaryValues() {
+				want := clampBig(op.big(x, y))
+				if got := op.op(new(Int), MustFromBig(x), MustFromBig(y)); got.ToBig().Cmp(want) != 0 {
+					t.Errorf("Int.%s(%v, %v) = %v, want %v", op.name, x, y, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestInt_SatAdd(t *testing.T) {
+	type args struct {
+		x *Int
+		y *Int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Int
+	}{
+		{
+			name: "Should return correct value when the sum is in range",
+			args: args{
+				x: NewInt(-10),
+				y: NewInt(3),
+			},
+			want: NewInt(-7),
+		},
+		{
+			name: "Should clamp to max int256 when the sum overflows",
+			args: args{
+				x: MaxInt256(),
+				y: MaxInt256(),
+			},
+			want: MaxInt256(),
+		},
+		{
+			name: "Should clamp to min int256 when the sum underflows",
+			args: args{
+				x: MinInt256(),
+				y: NewInt(-1),
+			},
+			want: MinInt256(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := new(Int).SatAdd(tt.args.x, tt.args.y); got.Cmp(tt.want) != 0 {
+				t.Errorf("Int.SatAdd() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
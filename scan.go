@@ -0,0 +1,184 @@
+package int256
+
+import (
+	"fmt"
+
+	"github.com/holiman/uint256"
+)
+
+// MaxBase is the largest number base accepted for string conversions.
+// It matches big.MaxBase.
+const MaxBase = 10 + ('z' - 'a' + 1) + ('Z' - 'A' + 1)
+
+// maxBaseSmall is the largest base for which upper- and lower-case letters
+// denote the same digit values.
+const maxBaseSmall = 10 + ('z' - 'a' + 1)
+
+// scanAbs sets z to the unsigned magnitude spelled by s in the given base,
+// following the rules of big.Int.SetString for the digits after the sign.
+// It returns ErrSyntax if s is not a valid number and ErrOverflow if s is
+// valid but its magnitude does not fit in 256 bits. Syntax errors take
+// precedence over range errors. The value of z is undefined on error.
+//
+// If base is not 0 and not in [2, MaxBase], scanAbs panics.
+func scanAbs(z *uint256.Int, s string, base int) error {
+	if base != 0 && (base < 2 || base > MaxBase) {
+		panic(fmt.Sprintf("invalid number base %d", base))
+	}
+	z.Clear()
+
+	// prev encodes the previously seen char: '_', '0' (a digit or a
+	// base prefix), or '.' (nothing yet). As in Go number literals, a
+	// separator '_' may only follow a digit or prefix and only if base == 0.
+	prev := byte('.')
+	b, prefix := base, byte(0)
+	if base == 0 {
+		b = 10
+		if len(s) > 0 && s[0] == '0' {
+			prev = '0'
+			if len(s) > 1 {
+				switch s[1] {
+				case 'b', 'B':
+					b, prefix = 2, 'b'
+				case 'o', 'O':
+					b, prefix = 8, 'o'
+				case 'x', 'X':
+					b, prefix = 16, 'x'
+				default:
+					b, prefix = 8, '0'
+				}
+			}
+			if prefix != 0 && prefix != '0' {
+				s = s[2:]
+			} else {
+				// The leading 0 of an octal literal is also a digit.
+				s = s[1:]
+				if prefix == 0 {
+					return nil // "0"
+				}
+			}
+		}
+	}
+
+	var (
+		bw       = uint256.NewInt(uint64(b))
+		d        uint256.Int
+		count    int
+		overflow bool
+	)
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch == '_' && base == 0 {
+			if prev != '0' {
+				return ErrSyntax
+			}
+			prev = '_'
+			continue
+		}
+		var digit int
+		switch {
+		case '0' <= ch && ch <= '9':
+			digit = int(ch - '0')
+		case 'a' <= ch && ch <= 'z':
+			digit = int(ch-'a') + 10
+		case 'A' <= ch && ch <= 'Z':
+			if b <= maxBaseSmall {
+				digit = int(ch-'A') + 10
+			} else {
+				digit = int(ch-'A') + maxBaseSmall
+			}
+		default:
+			return ErrSyntax
+		}
+		if digit >= b {
+			return ErrSyntax
+		}
+		prev = '0'
+		count++
+		if overflow {
+			continue
+		}
+		var o1, o2 bool
+		_, o1 = z.MulOverflow(z, bw)
+		_, o2 = z.AddOverflow(z, d.SetUint64(uint64(digit)))
+		overflow = o1 || o2
+	}
+	if prev == '_' {
+		return ErrSyntax
+	}
+	if count == 0 && prefix != '0' {
+		return ErrSyntax
+	}
+	if overflow {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// FromDecimal is a convenience-constructor to create an Int from a decimal
+// string with an optional sign. It returns ErrSyntax if s is not a valid
+// decimal number and ErrOverflow if its value lies outside the int256
+// range; it never panics.
+func FromDecimal(s string) (*Int, error) {
+	return new(Int).SetString(s, 10)
+}
+
+// FromHex is a convenience-constructor to create an Int from a hexadecimal
+// string. The string has an optional sign followed by a mandatory "0x" or
+// "0X" prefix, e.g. "-0x2a". It returns ErrSyntax if s is malformed and
+// ErrOverflow if its value lies outside the int256 range; it never panics.
+func FromHex(s string) (*Int, error) {
+	neg, s := cutSign(s)
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return nil, ErrSyntax
+	}
+	return new(Int).scan(s[2:], neg, 16)
+}
+
+// MustFromDecimal is like FromDecimal, but panics if s cannot be parsed.
+// It is intended for constants and must not be used on untrusted input.
+func MustFromDecimal(s string) *Int {
+	z, err := FromDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// MustFromHex is like FromHex, but panics if s cannot be parsed.
+// It is intended for constants and must not be used on untrusted input.
+func MustFromHex(s string) *Int {
+	z, err := FromHex(s)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// cutSign strips a single leading "+" or "-" from s and reports whether
+// it was a minus sign.
+func cutSign(s string) (neg bool, rest string) {
+	if len(s) > 0 {
+		switch s[0] {
+		case '-':
+			return true, s[1:]
+		case '+':
+			return false, s[1:]
+		}
+	}
+	return false, s
+}
+
+// scan sets z to the value with sign neg and the magnitude spelled by s in
+// the given base, and returns z. On error it returns nil and leaves z
+// unchanged.
+func (z *Int) scan(s string, neg bool, base int) (*Int, error) {
+	var abs uint256.Int
+	if err := scanAbs(&abs, s, base); err != nil {
+		return nil, err
+	}
+	if !inRange(&abs, neg) {
+		return nil, ErrOverflow
+	}
+	return z.setAbs(&abs, neg), nil // "-0" is 0
+}
@@ -0,0 +1,200 @@
+package int256
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestInt_SetStringBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		base    int
+		want    string
+		wantErr error
+	}{
+		{name: "Should parse decimal with base 0", s: "-1234", base: 0, want: "-1234"},
+		{name: "Should parse explicit plus sign", s: "+42", base: 10, want: "42"},
+		{name: "Should parse hex prefix with base 0", s: "0x1F", base: 0, want: "31"},
+		{name: "Should parse upper case hex prefix", s: "-0XfF", base: 0, want: "-255"},
+		{name: "Should parse octal prefix with base 0", s: "0o17", base: 0, want: "15"},
+		{name: "Should parse legacy octal with base 0", s: "017", base: 0, want: "15"},
+		{name: "Should parse binary prefix with base 0", s: "-0b101", base: 0, want: "-5"},
+		{name: "Should parse single zero with base 0", s: "0", base: 0, want: "0"},
+		{name: "Should parse underscores with base 0", s: "1_000_000", base: 0, want: "1000000"},
+		{name: "Should parse underscore after prefix", s: "0x_ff_ff", base: 0, want: "65535"},
+		{name: "Should parse underscore after legacy octal prefix", s: "0_7", base: 0, want: "7"},
+		{name: "Should parse base 36", s: "Zz", base: 36, want: "1295"},
+		{name: "Should parse base 62 with distinct cases", s: "Zz", base: 62, want: "3817"},
+		{name: "Should parse max int256 in hex", s: "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", base: 0, want: "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{name: "Should parse min int256 in hex", s: "-0x8000000000000000000000000000000000000000000000000000000000000000", base: 0, want: "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{name: "Should reject empty string", s: "", base: 10, wantErr: ErrSyntax},
+		{name: "Should reject lone sign", s: "-", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject double sign", s: "+-1", base: 10, wantErr: ErrSyntax},
+		{name: "Should reject prefix without digits", s: "0x", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject prefix with explicit base", s: "0x10", base: 16, wantErr: ErrSyntax},
+		{name: "Should reject digit outside base", s: "12a", base: 10, wantErr: ErrSyntax},
+		{name: "Should reject invalid legacy octal digit", s: "08", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject underscores with explicit base", s: "1_000", base: 10, wantErr: ErrSyntax},
+		{name: "Should reject leading underscore", s: "_1", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject trailing underscore", s: "1_", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject consecutive underscores", s: "1__0", base: 0, wantErr: ErrSyntax},
+		{name: "Should reject whitespace", s: " 1", base: 10, wantErr: ErrSyntax},
+		{name: "Should reject max int256 plus one", s: "0x8000000000000000000000000000000000000000000000000000000000000000", base: 0, wantErr: ErrOverflow},
+		{name: "Should reject min int256 minus one", s: "-57896044618658097711785492504343953926634992332820282019728792003956564819969", base: 10, wantErr: ErrOverflow},
+		{name: "Should reject value beyond 256 bits", s: "100000000000000000000000000000000000000000000000000000000000000000000000000000000", base: 10, wantErr: ErrOverflow},
+		{name: "Should prefer syntax error over range error", s: "100000000000000000000000000000000000000000000000000000000000000000000000000000000x", base: 10, wantErr: ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := NewInt(7)
+			got, err := z.SetString(tt.s, tt.base)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Int.SetString(%q, %d) error = %v, want %v", tt.s, tt.base, err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if got != nil || z.Int64() != 7 {
+					t.Errorf("Int.SetString(%q, %d) = %v, z = %v, want nil and z unchanged", tt.s, tt.base, got, z)
+				}
+				return
+			}
+			if got != z || got.String() != tt.want {
+				t.Errorf("Int.SetString(%q, %d) = %v, want %v", tt.s, tt.base, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_SetStringMatchesBig(t *testing.T) {
+	inputs := []string{
+		"0", "-0", "+0", "00", "0_0", "1", "-1", "0b", "0b_1", "0b1_", "0o777", "0O1_2",
+		"0xdead_beef", "0XDEADbeef", "0_x1", "1_2_3", "-_1", "007", "09", "0.5", "1e3",
+		"zz", "ZZ", "Az", "++1", "--1", "-+1", "",
+	}
+	bases := []int{0, 2, 8, 10, 16, 36, 37, 62}
+	for _, s := range inputs {
+		for _, base := range bases {
+			want, ok := new(big.Int).SetString(s, base)
+			got, err := new(Int).SetString(s, base)
+			if ok != (err == nil) {
+				t.Errorf("Int.SetString(%q, %d) error = %v, big ok = %v", s, base, err, ok)
+				continue
+			}
+			if ok && got.ToBig().Cmp(want) != 0 {
+				t.Errorf("Int.SetString(%q, %d) = %v, want %v", s, base, got, want)
+			}
+		}
+	}
+}
+
+func TestInt_SetStringInvalidBase(t *testing.T) {
+	for _, base := range []int{-1, 1, MaxBase + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Int.SetString(\"1\", %d) did not panic", base)
+				}
+			}()
+			new(Int).SetString("1", base)
+		}()
+	}
+}
+
+func TestFromDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "Should parse positive value", s: "123", want: "123"},
+		{name: "Should parse negative value", s: "-123", want: "-123"},
+		{name: "Should parse max int256", s: "57896044618658097711785492504343953926634992332820282019728792003956564819967", want: "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{name: "Should parse min int256", s: "-57896044618658097711785492504343953926634992332820282019728792003956564819968", want: "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{name: "Should reject max int256 plus one", s: "57896044618658097711785492504343953926634992332820282019728792003956564819968", wantErr: ErrOverflow},
+		{name: "Should reject max uint256", s: "115792089237316195423570985008687907853269984665640564039457584007913129639935", wantErr: ErrOverflow},
+		{name: "Should reject hex input", s: "0x10", wantErr: ErrSyntax},
+		{name: "Should reject underscores", s: "1_000", wantErr: ErrSyntax},
+		{name: "Should reject empty string", s: "", wantErr: ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromDecimal(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromDecimal(%q) error = %v, want %v", tt.s, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("FromDecimal(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "Should parse positive value", s: "0x2a", want: "42"},
+		{name: "Should parse negative value", s: "-0X2A", want: "-42"},
+		{name: "Should parse explicit plus sign", s: "+0x2a", want: "42"},
+		{name: "Should parse min int256", s: "-0x8000000000000000000000000000000000000000000000000000000000000000", want: "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{name: "Should reject max int256 plus one", s: "0x8000000000000000000000000000000000000000000000000000000000000000", wantErr: ErrOverflow},
+		{name: "Should reject more than 256 bits", s: "0x10000000000000000000000000000000000000000000000000000000000000000", wantErr: ErrOverflow},
+		{name: "Should reject missing prefix", s: "2a", wantErr: ErrSyntax},
+		{name: "Should reject prefix without digits", s: "0x", wantErr: ErrSyntax},
+		{name: "Should reject sign after prefix", s: "0x-2a", wantErr: ErrSyntax},
+		{name: "Should reject non-hex digit", s: "0x2g", wantErr: ErrSyntax},
+		{name: "Should reject underscores", s: "0x_2a", wantErr: ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromHex(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromHex(%q) error = %v, want %v", tt.s, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("FromHex(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustFrom(t *testing.T) {
+	if got := MustFromDecimal("-7"); got.Int64() != -7 {
+		t.Errorf("MustFromDecimal() = %v, want -7", got)
+	}
+	if got := MustFromHex("-0x7"); got.Int64() != -7 {
+		t.Errorf("MustFromHex() = %v, want -7", got)
+	}
+	for _, f := range []func(){
+		func() { MustFromDecimal("1x") },
+		func() { MustFromHex("0x8000000000000000000000000000000000000000000000000000000000000000") },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Must constructor did not panic on invalid input")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestParseUntrustedInputNeverPanics(t *testing.T) {
+	property := func(s string) bool {
+		FromDecimal(s)
+		FromHex(s)
+		FromHex("0x" + s)
+		new(Int).SetString(s, 0)
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 10000}); err != nil {
+		t.Error(err)
+	}
+}
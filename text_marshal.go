@@ -0,0 +1,37 @@
+package int256
+
+// MarshalText implements encoding.TextMarshaler. It encodes z in decimal.
+//
+// MarshalText has a value receiver so that an Int held by value, for
+// example in a struct field or a map key, is encoded by encoding/json and
+// other text-based encoders. Note that Int values compare by their internal
+// pointer, so two equal Ints are distinct map keys and lookups with an
+// equal value always miss; use Int256 for map keys instead.
+func (z Int) MarshalText() ([]byte, error) {
+	return z.Append(nil, 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts a decimal
+// number or a hexadecimal number with a "0x" or "0X" prefix, either with an
+// optional sign, as in "-123" or "-0x7b". Malformed input yields ErrSyntax
+// and values outside the int256 range yield ErrOverflow; z is left
+// unchanged on error.
+func (z *Int) UnmarshalText(text []byte) error {
+	return z.unmarshalString(string(text))
+}
+
+// MarshalText implements encoding.TextMarshaler. It encodes x in decimal.
+func (x Int256) MarshalText() ([]byte, error) {
+	return x.Int().MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with the same syntax
+// as (*Int).UnmarshalText.
+func (x *Int256) UnmarshalText(text []byte) error {
+	var z Int
+	if err := z.UnmarshalText(text); err != nil {
+		return err
+	}
+	*x = z.Int256()
+	return nil
+}
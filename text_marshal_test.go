@@ -0,0 +1,116 @@
+package int256
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    *Int
+		wantErr error
+	}{
+		{name: "Should parse decimal", text: "-123", want: NewInt(-123)},
+		{name: "Should parse explicit plus sign", text: "+123", want: NewInt(123)},
+		{name: "Should parse hex", text: "0x7b", want: NewInt(123)},
+		{name: "Should parse negative hex", text: "-0X7B", want: NewInt(-123)},
+		{name: "Should parse min int256", text: "-0x8000000000000000000000000000000000000000000000000000000000000000", want: MinInt256()},
+		{name: "Should reject empty text", text: "", wantErr: ErrSyntax},
+		{name: "Should reject quoted text", text: `"1"`, wantErr: ErrSyntax},
+		{name: "Should reject octal prefix", text: "0o17", wantErr: ErrSyntax},
+		{name: "Should reject underscores", text: "1_000", wantErr: ErrSyntax},
+		{name: "Should reject out of range value", text: "0x8000000000000000000000000000000000000000000000000000000000000000", wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := NewInt(7)
+			err := z.UnmarshalText([]byte(tt.text))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Int.UnmarshalText(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			if err != nil {
+				tt.want = NewInt(7)
+			}
+			assert.Equal(t, tt.want, z)
+
+			var x Int256
+			err = x.UnmarshalText([]byte(tt.text))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Int256.UnmarshalText(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			if err == nil && x.Int().Cmp(tt.want) != 0 {
+				t.Errorf("Int256.UnmarshalText(%q) = %v, want %v", tt.text, x, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_MarshalText(t *testing.T) {
+	for _, x := range []*Int{New(), NewInt(123), NewInt(-123), MaxInt256(), MinInt256()} {
+		text, err := x.MarshalText()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, x.String(), string(text))
+
+		text, err = x.Int256().MarshalText()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, x.String(), string(text))
+	}
+}
+
+func TestInt_TextMapKey(t *testing.T) {
+	positions := map[Int]string{
+		*NewInt(-887272): "lower",
+		*NewInt(887272):  "upper",
+	}
+	data, err := json.Marshal(positions)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"-887272":"lower","887272":"upper"}`, string(data))
+
+	var decoded map[Int]string
+	assert.Equal(t, nil, json.Unmarshal(data, &decoded))
+	got := make(map[string]string)
+	for k, v := range decoded {
+		got[k.String()] = v
+	}
+	assert.Equal(t, map[string]string{"-887272": "lower", "887272": "upper"}, got)
+
+	// Int256 compares by value, so decoded keys can be looked up directly.
+	balances := map[Int256]int{NewInt256(-1): 1, NewInt256(2): 2}
+	data, err = json.Marshal(balances)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `{"-1":1,"2":2}`, string(data))
+
+	var decodedBalances map[Int256]int
+	assert.Equal(t, nil, json.Unmarshal(data, &decodedBalances))
+	assert.Equal(t, balances, decodedBalances)
+
+	err = json.Unmarshal([]byte(`{"1.5":1}`), &decodedBalances)
+	assert.True(t, errors.Is(err, ErrSyntax))
+}
+
+func TestInt_TextEncoders(t *testing.T) {
+	type position struct {
+		XMLName xml.Name `xml:"position"`
+		Tick    *Int     `xml:"tick,attr"`
+	}
+	data, err := xml.Marshal(position{Tick: NewInt(-60)})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, `<position tick="-60"></position>`, string(data))
+
+	var p position
+	assert.Equal(t, nil, xml.Unmarshal([]byte(`<position tick="-0x3c"></position>`), &p))
+	assert.Equal(t, NewInt(-60), p.Tick)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	tick := new(Int)
+	fs.TextVar(tick, "tick", NewInt(0), "tick index")
+	assert.Equal(t, nil, fs.Parse([]string{"-tick", "-0x3c"}))
+	assert.Equal(t, NewInt(-60), tick)
+}
@@ -0,0 +1,72 @@
+package int256
+
+import "strings"
+
+// ParseUnits parses a decimal amount such as "-1.5" and returns it scaled by
+// 10^decimals as an Int, e.g. ParseUnits("1.5", 18) is 1500000000000000000.
+// The conversion is exact. The amount has an optional sign, an integer part
+// and an optional fraction after a '.', at least one of which is non-empty.
+//
+// It returns ErrPrecision if the fraction has non-zero digits beyond the
+// given number of decimals, ErrSyntax if s is malformed, and ErrOverflow if
+// the scaled value lies outside the int256 range.
+func ParseUnits(s string, decimals uint8) (*Int, error) {
+	neg, s := cutSign(s)
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return nil, ErrSyntax
+	}
+	if len(frac) > int(decimals) {
+		excess := frac[decimals:]
+		if strings.Trim(excess, "0") != "" {
+			// Report malformed input before lost precision.
+			if strings.Trim(excess, "0123456789") != "" {
+				return nil, ErrSyntax
+			}
+			return nil, ErrPrecision
+		}
+		frac = frac[:decimals]
+	}
+	digits := whole + frac + strings.Repeat("0", int(decimals)-len(frac))
+	return new(Int).scan(digits, neg, 10)
+}
+
+// FormatUnits returns the decimal representation of z divided by
+// 10^decimals, e.g. "-1.5" for -1500000000000000000 with 18 decimals.
+// The conversion is exact: trailing zeros of the fraction are dropped, and
+// so is the decimal point when the fraction is zero.
+func (z *Int) FormatUnits(decimals uint8) string {
+	z = operand(z)
+
+	// The 78 digits of 2^256-1.
+	var buf [78]byte
+	digits := appendAbs(buf[:0], z.abs, 10)
+
+	var sb strings.Builder
+	sb.Grow(len(digits) + int(decimals) + 3)
+	if z.neg {
+		sb.WriteByte('-')
+	}
+	point := len(digits) - int(decimals)
+	if point <= 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.Write(digits[:point])
+	}
+
+	start, end := point, len(digits)
+	if start < 0 {
+		start = 0
+	}
+	for end > start && digits[end-1] == '0' {
+		end--
+	}
+	if end > start {
+		sb.WriteByte('.')
+		for i := point; i < 0; i++ {
+			sb.WriteByte('0')
+		}
+		sb.Write(digits[start:end])
+	}
+	return sb.String()
+}
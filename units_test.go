@@ -0,0 +1,76 @@
+package int256
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		decimals uint8
+		want     string
+		wantErr  error
+	}{
+		{name: "Should scale whole amount", s: "1", decimals: 18, want: "1000000000000000000"},
+		{name: "Should scale negative fraction", s: "-1.5", decimals: 18, want: "-1500000000000000000"},
+		{name: "Should scale explicit plus sign", s: "+0.25", decimals: 2, want: "25"},
+		{name: "Should accept missing integer part", s: ".5", decimals: 1, want: "5"},
+		{name: "Should accept missing fraction", s: "7.", decimals: 1, want: "70"},
+		{name: "Should accept zero decimals", s: "-42", decimals: 0, want: "-42"},
+		{name: "Should accept excess trailing zeros", s: "1.2300", decimals: 2, want: "123"},
+		{name: "Should normalize negative zero", s: "-0.0", decimals: 6, want: "0"},
+		{name: "Should parse min int256", s: "-57896044618658097711785492504343953926634992332820282019728792003956564.819968", decimals: 6, want: "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{name: "Should reject excess precision", s: "1.234", decimals: 2, wantErr: ErrPrecision},
+		{name: "Should reject fraction with zero decimals", s: "1.5", decimals: 0, wantErr: ErrPrecision},
+		{name: "Should reject lone point", s: ".", decimals: 18, wantErr: ErrSyntax},
+		{name: "Should reject empty string", s: "", decimals: 18, wantErr: ErrSyntax},
+		{name: "Should reject second point", s: "1.2.3", decimals: 18, wantErr: ErrSyntax},
+		{name: "Should reject second point in excess digits", s: "1.2.3", decimals: 1, wantErr: ErrSyntax},
+		{name: "Should reject exponent", s: "1e18", decimals: 18, wantErr: ErrSyntax},
+		{name: "Should reject sign after point", s: "1.-5", decimals: 18, wantErr: ErrSyntax},
+		{name: "Should reject out of range amount", s: "57896044618658097711785492504343953926634992332820282019728792003956564.819968", decimals: 6, wantErr: ErrOverflow},
+		{name: "Should reject amount overflowing after scaling", s: "1", decimals: 77, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUnits(tt.s, tt.decimals)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUnits(%q, %d) error = %v, want %v", tt.s, tt.decimals, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseUnits(%q, %d) = %v, want %v", tt.s, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_FormatUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        *Int
+		decimals uint8
+		want     string
+	}{
+		{name: "Should format whole amount", x: MustFromDecimal("1000000000000000000"), decimals: 18, want: "1"},
+		{name: "Should format negative fraction", x: MustFromDecimal("-1500000000000000000"), decimals: 18, want: "-1.5"},
+		{name: "Should format amount below one", x: NewInt(-25), decimals: 6, want: "-0.000025"},
+		{name: "Should format zero", x: New(), decimals: 18, want: "0"},
+		{name: "Should format zero decimals", x: NewInt(-42), decimals: 0, want: "-42"},
+		{name: "Should format decimals beyond digit count", x: NewInt(1), decimals: 255, want: "0." + strings.Repeat("0", 254) + "1"},
+		{name: "Should format min int256", x: MinInt256(), decimals: 18, want: "-57896044618658097711785492504343953926634992332820282019728.792003956564819968"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.x.FormatUnits(tt.decimals)
+			if got != tt.want {
+				t.Errorf("Int.FormatUnits(%d) = %v, want %v", tt.decimals, got, tt.want)
+			}
+			if back, err := ParseUnits(got, tt.decimals); err != nil || back.Cmp(tt.x) != 0 {
+				t.Errorf("ParseUnits(%v, %d) = %v, %v, want %v", got, tt.decimals, back, err, tt.x)
+			}
+		})
+	}
+}
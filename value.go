@@ -0,0 +1,73 @@
+package int256
+
+import (
+	"fmt"
+
+	"github.com/holiman/uint256"
+)
+
+// Int256 is a signed 256-bit integer stored by value as its two's-complement
+// encoding in four little-endian 64-bit limbs, the same layout as
+// uint256.Int. Unlike Int it holds no pointer, so it can be copied by
+// assignment, stored in arrays and used as a map key, and two Int256 values
+// are equal exactly when they compare equal with ==.
+type Int256 [4]uint64
+
+// NewInt256 returns x as an Int256.
+func NewInt256(x int64) Int256 {
+	return Int256{uint64(x), uint64(x >> 63), uint64(x >> 63), uint64(x >> 63)}
+}
+
+// Int256 returns the Int256 representation of z.
+func (z *Int) Int256() Int256 {
+	var w uint256.Int
+	return Int256(*z.toWord(&w))
+}
+
+// SetInt256 sets z to x and returns z.
+func (z *Int) SetInt256(x Int256) *Int {
+	w := uint256.Int(x)
+	return z.setWord(&w)
+}
+
+// Int allocates and returns a new Int set to x.
+func (x Int256) Int() *Int {
+	return new(Int).SetInt256(x)
+}
+
+// Sign returns:
+//
+//	-1 if x <  0
+//	 0 if x == 0
+//	+1 if x >  0
+func (x Int256) Sign() int {
+	w := uint256.Int(x)
+	return w.Sign()
+}
+
+// Cmp compares x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+func (x Int256) Cmp(y Int256) int {
+	a, b := uint256.Int(x), uint256.Int(y)
+	switch {
+	case a.Slt(&b):
+		return -1
+	case a.Sgt(&b):
+		return 1
+	}
+	return 0
+}
+
+// String returns the decimal representation of x.
+func (x Int256) String() string {
+	return x.Int().String()
+}
+
+// Format implements fmt.Formatter with the same verbs and flags as
+// (*Int).Format.
+func (x Int256) Format(s fmt.State, ch rune) {
+	x.Int().Format(s, ch)
+}
@@ -0,0 +1,66 @@
+package int256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInt256_RoundTrip(t *testing.T) {
+	for _, x := range boundaryValues() {
+		v := MustFromBig(x).Int256()
+		if got := v.Int(); got.ToBig().Cmp(x) != 0 {
+			t.Errorf("Int256.Int() = %v, want %v", got, x)
+		}
+		if got := v.String(); got != x.String() {
+			t.Errorf("Int256.String() = %v, want %v", got, x)
+		}
+		if got := v.Sign(); got != x.Sign() {
+			t.Errorf("Int256.Sign(%v) = %v, want %v", x, got, x.Sign())
+		}
+		for _, y := range boundaryValues() {
+			if got, want := v.Cmp(MustFromBig(y).Int256()), x.Cmp(y); got != want {
+				t.Errorf("Int256.Cmp(%v, %v) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestNewInt256(t *testing.T) {
+	tests := []struct {
+		name string
+		x    int64
+	}{
+		{
+			name: "Should return correct value when x is positive",
+			x:    10,
+		},
+		{
+			name: "Should return correct value when x is negative",
+			x:    -10,
+		},
+		{
+			name: "Should return correct value when x is zero",
+			x:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := NewInt256(tt.x), NewInt(tt.x).Int256(); got != want {
+				t.Errorf("NewInt256() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestInt256_ValueSemantics(t *testing.T) {
+	a := NewInt(-42).Int256()
+	b := a
+	b[0]++
+	if a != NewInt256(-42) || b != NewInt256(-41) {
+		t.Errorf("copies of an Int256 should not alias: a = %v, b = %v", a, b)
+	}
+	index := map[Int256]string{NewInt256(-1): "minus one"}
+	if index[MustFromBig(big.NewInt(-1)).Int256()] != "minus one" {
+		t.Errorf("equal Int256 values should be equal map keys")
+	}
+}